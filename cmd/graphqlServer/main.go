@@ -1,42 +1,70 @@
 package main
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
 	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
 	"github.com/kingsukhoi/qbitorrent-panel/pkg/routers"
+	"github.com/labstack/echo/v5"
 )
 
 func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	configFile, exist := os.LookupEnv("CONFIG_FILE")
 	if !exist {
 		configFile = "./dev.yaml"
 	}
-	cfg := configuration.MustGetConfig(configFile)
+	cfg, err := configuration.InitConfig(configFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
 	// init the clients before graphql
 
+	logLevel := &slog.LevelVar{}
+	level, err := cfg.GetLogLevel()
+	if err != nil {
+		panic(err)
+	}
+	logLevel.Set(level)
+
 	if cfg.GetEnv() == configuration.EnvDev {
 		slog.SetDefault(slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
-			Level: slog.LevelDebug,
+			Level: logLevel,
 		})))
 	} else { // production
 		slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-			Level: slog.LevelInfo,
+			Level: logLevel,
 		})))
 	}
 
-	qbClient.Registry()
+	if !cfg.Auth.Enabled() {
+		slog.Warn("auth.users is empty, anyone who can reach the panel can use it")
+	}
+
+	registry := qbClient.Registry()
+	go registry.ProbeHealth(ctx)
+
+	go configuration.WatchConfig(ctx, func(old, new configuration.Config) {
+		applyConfig(old, new, logLevel)
+	})
 
 	h := routers.NewGraphqlHandler()
 
 	// Create Echo instance
 	e := routers.NewEchoHandler(h)
 
-	err := e.Start("0.0.0.0:" + cfg.Port)
+	err = echo.StartConfig{Address: "0.0.0.0:" + cfg.Port}.Start(ctx, e)
 
 	if err != nil {
 		if !errors.Is(err, http.ErrServerClosed) {
@@ -44,3 +72,21 @@ func main() {
 		}
 	}
 }
+
+// applyConfig applies the settings that can change while running and logs what changed.
+func applyConfig(old, new configuration.Config, logLevel *slog.LevelVar) {
+	changes := qbClient.Registry().Sync(old.Endpoints, new.Endpoints)
+
+	level, _ := new.GetLogLevel() // already validated by ReloadConfig
+	if level != logLevel.Level() {
+		changes = append(changes, "log level "+logLevel.Level().String()+" -> "+level.String())
+		logLevel.Set(level)
+	}
+
+	slog.Info("Config reloaded", "changes", changes)
+
+	restartRequired := configuration.RestartRequired(old, new)
+	if len(restartRequired) > 0 {
+		slog.Warn("Some config changes only take effect after a restart", "settings", restartRequired)
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	"github.com/alecthomas/kong"
 	"github.com/kingsukhoi/qbitorrent-panel/pkg/commands"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
 )
 
 func main() {
@@ -22,6 +24,13 @@ func main() {
 		kong.UsageOnError(),
 	)
 
+	// config check loads the file itself so it can report every problem, output-schema doesn't need one.
+	// Everything else needs a valid config.
+	if !strings.HasPrefix(kongCtx.Command(), "config check") && kongCtx.Command() != "output-schema" {
+		_, err := configuration.InitConfig(commands.CLI.Config)
+		kongCtx.FatalIfErrorf(err)
+	}
+
 	err := kongCtx.Run()
 	kongCtx.FatalIfErrorf(err)
 }
@@ -5,6 +5,8 @@ import Toolbar from "./components/Toolbar";
 import Sidebar from "./components/Sidebar";
 import TorrentTable from "./components/TorrentTable";
 import DetailsPanel from "./components/DetailsPanel";
+import LoginForm from "./components/LoginForm";
+import { useAuthStatus } from "./hooks/useAuth";
 import { useTorrents } from "./hooks/useTorrents";
 import type { Torrent } from "./types";
 
@@ -144,10 +146,23 @@ function QBittorrentPanel() {
 	);
 }
 
+// Shows the login form instead of the panel while the backend requires a login.
+function AuthGate() {
+	const { data: authData, isPending } = useAuthStatus();
+
+	if (isPending) {
+		return null;
+	}
+	if (authData?.AuthStatus.Enabled && !authData.AuthStatus.User) {
+		return <LoginForm />;
+	}
+	return <QBittorrentPanel />;
+}
+
 function App() {
 	return (
 		<QueryClientProvider client={queryClient}>
-			<QBittorrentPanel />
+			<AuthGate />
 		</QueryClientProvider>
 	);
 }
@@ -0,0 +1,79 @@
+import { useState } from "react";
+import { useLogin } from "../hooks/useAuth";
+
+export default function LoginForm() {
+	const [username, setUsername] = useState("");
+	const [password, setPassword] = useState("");
+	const [error, setError] = useState<string | null>(null);
+	const { mutateAsync: login, isPending } = useLogin();
+
+	const handleSubmit = async (e: React.FormEvent) => {
+		e.preventDefault();
+		setError(null);
+		try {
+			await login({ Username: username, Password: password });
+		} catch {
+			setError("Wrong username or password");
+			setPassword("");
+		}
+	};
+
+	return (
+		<div className="h-screen flex items-center justify-center bg-[var(--qbt-bg-primary)]">
+			<form
+				onSubmit={handleSubmit}
+				className="bg-[var(--qbt-bg-secondary)] border border-[var(--qbt-border)] rounded-lg w-full max-w-sm shadow-xl p-6 space-y-4"
+			>
+				<h1 className="text-lg font-semibold text-[var(--qbt-text-primary)]">
+					Log in
+				</h1>
+				<div>
+					<label
+						htmlFor="login-username"
+						className="block text-sm font-medium text-[var(--qbt-text-primary)] mb-1"
+					>
+						Username
+					</label>
+					<input
+						id="login-username"
+						type="text"
+						autoComplete="username"
+						value={username}
+						onChange={(e) => setUsername(e.target.value)}
+						required
+						className="w-full px-3 py-2 bg-[var(--qbt-bg-primary)] border border-[var(--qbt-border)] rounded text-[var(--qbt-text-primary)] focus:outline-none focus:border-[var(--qbt-accent)] transition-colors"
+					/>
+				</div>
+				<div>
+					<label
+						htmlFor="login-password"
+						className="block text-sm font-medium text-[var(--qbt-text-primary)] mb-1"
+					>
+						Password
+					</label>
+					<input
+						id="login-password"
+						type="password"
+						autoComplete="current-password"
+						value={password}
+						onChange={(e) => setPassword(e.target.value)}
+						required
+						className="w-full px-3 py-2 bg-[var(--qbt-bg-primary)] border border-[var(--qbt-border)] rounded text-[var(--qbt-text-primary)] focus:outline-none focus:border-[var(--qbt-accent)] transition-colors"
+					/>
+				</div>
+				{error && (
+					<div className="p-3 bg-red-500/10 border border-red-500/30 rounded text-red-400 text-sm">
+						{error}
+					</div>
+				)}
+				<button
+					type="submit"
+					disabled={!username || !password || isPending}
+					className="w-full px-4 py-2 bg-[var(--qbt-accent)] hover:bg-[var(--qbt-accent)]/80 text-white rounded transition-colors disabled:opacity-50 disabled:cursor-not-allowed"
+				>
+					{isPending ? "Logging in..." : "Log in"}
+				</button>
+			</form>
+		</div>
+	);
+}
@@ -1,5 +1,6 @@
 import {
 	Filter,
+	LogOut,
 	Pause,
 	Play,
 	Plus,
@@ -8,6 +9,7 @@ import {
 	Trash2,
 } from "lucide-react";
 import { lazy, Suspense, useState } from "react";
+import { useAuthStatus, useLogout } from "../hooks/useAuth";
 import {
 	usePauseTorrents,
 	useResumeTorrents,
@@ -33,6 +35,9 @@ export default function Toolbar({
 	const [isDeleteModalOpen, setIsDeleteModalOpen] = useState(false);
 	const { mutate: pauseTorrents } = usePauseTorrents();
 	const { mutate: resumeTorrents } = useResumeTorrents();
+	const { data: authData } = useAuthStatus();
+	const { mutate: logout } = useLogout();
+	const user = authData?.AuthStatus.User;
 
 	const targets = selectedTorrents?.length
 		? selectedTorrents.map((t) => ({ Server: t.Server, Hash: t.InfoHashV1 }))
@@ -121,6 +126,16 @@ export default function Toolbar({
 				>
 					<RotateCcw size={18} />
 				</button>
+				{user && (
+					<button
+						type="button"
+						className="p-2 hover:bg-[var(--qbt-bg-tertiary)] rounded transition-colors"
+						title={`Log out ${user}`}
+						onClick={() => logout()}
+					>
+						<LogOut size={18} />
+					</button>
+				)}
 			</div>
 			{/* Mobile bottom action bar — shown when torrents selected */}
 			{!!selectedTorrents?.length && (
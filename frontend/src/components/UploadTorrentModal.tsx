@@ -11,7 +11,7 @@ import {
 import { Check, ChevronDown, Upload, X } from "lucide-react";
 import { useId, useRef, useState } from "react";
 import { useCategories } from "../hooks/useCategories";
-import { getApiUrl } from "../lib/api";
+import { csrfHeaders, getApiUrl } from "../lib/api";
 
 interface UploadTorrentModalProps {
 	isOpen: boolean;
@@ -91,6 +91,8 @@ export default function UploadTorrentModal({
 			const response = await fetch(getApiUrl("/uploadTorrent"), {
 				method: "POST",
 				body: formData,
+				credentials: "include",
+				headers: csrfHeaders(),
 			});
 
 			if (!response.ok) {
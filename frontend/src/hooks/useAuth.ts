@@ -0,0 +1,43 @@
+import { useMutation, useQuery, useQueryClient } from "@tanstack/react-query";
+import { graphqlClient } from "../lib/graphqlClient";
+import { AUTH_STATUS, LOGIN, LOGOUT } from "../queries";
+
+interface AuthStatus {
+	Enabled: boolean;
+	User: string | null;
+}
+
+interface LoginArgs {
+	Username: string;
+	Password: string;
+}
+
+// Polled so an expired session goes back to the login form.
+export function useAuthStatus() {
+	return useQuery({
+		queryKey: ["authStatus"],
+		queryFn: () =>
+			graphqlClient.request<{ AuthStatus: AuthStatus }>(AUTH_STATUS),
+		refetchInterval: 30000,
+	});
+}
+
+export function useLogin() {
+	const queryClient = useQueryClient();
+	return useMutation({
+		mutationFn: (args: LoginArgs) => graphqlClient.request(LOGIN, { args }),
+		onSuccess: () => {
+			queryClient.invalidateQueries();
+		},
+	});
+}
+
+export function useLogout() {
+	const queryClient = useQueryClient();
+	return useMutation({
+		mutationFn: () => graphqlClient.request(LOGOUT),
+		onSuccess: () => {
+			queryClient.invalidateQueries({ queryKey: ["authStatus"] });
+		},
+	});
+}
@@ -5,3 +5,12 @@ export function getApiUrl(path: string = ""): string {
 	const normalizedPath = path.startsWith("/") ? path : `/${path}`;
 	return `${normalizedBase}${normalizedPath}`;
 }
+
+// The backend rejects requests that change something without the CSRF token it set in the qbp_csrf cookie at login.
+export function csrfHeaders(): Record<string, string> {
+	const token = document.cookie
+		.split("; ")
+		.find((cookie) => cookie.startsWith("qbp_csrf="))
+		?.slice("qbp_csrf=".length);
+	return token ? { "X-CSRF-Token": token } : {};
+}
@@ -1,4 +1,7 @@
 import { GraphQLClient } from "graphql-request";
-import { getApiUrl } from "./api";
+import { csrfHeaders, getApiUrl } from "./api";
 
-export const graphqlClient = new GraphQLClient(getApiUrl("/query"));
+export const graphqlClient = new GraphQLClient(getApiUrl("/query"), {
+	credentials: "include",
+	headers: () => csrfHeaders(),
+});
@@ -91,3 +91,26 @@ export const DELETE_TORRENTS = `
         }
     }
 `;
+
+export const AUTH_STATUS = `
+    query AuthStatus {
+        AuthStatus {
+            Enabled
+            User
+        }
+    }
+`;
+
+export const LOGIN = `
+    mutation Login($args: LoginArgs!) {
+        login(args: $args) {
+            User
+        }
+    }
+`;
+
+export const LOGOUT = `
+    mutation Logout {
+        logout
+    }
+`;
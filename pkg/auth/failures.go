@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"sync"
+	"time"
+)
+
+// LoginFailures counts failed logins per key, ex. a user name or a client address, to slow down password guessing.
+// Counts are kept in memory, like the sessions.
+type LoginFailures struct {
+	mu       sync.Mutex
+	failures map[string]loginFailure
+}
+
+// loginFailure is count failed logins since first, the count starts over once the window after first has passed.
+type loginFailure struct {
+	count int
+	first time.Time
+}
+
+func NewLoginFailures() *LoginFailures {
+	return &LoginFailures{failures: make(map[string]loginFailure)}
+}
+
+// Blocked returns how long until a login is allowed again, or 0 when none of keys has failed limit times within
+// window. A limit of 0 never blocks.
+func (f *LoginFailures) Blocked(limit int, window time.Duration, keys ...string) time.Duration {
+	if limit <= 0 {
+		return 0
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var rtnMe time.Duration
+	now := time.Now()
+	for _, key := range keys {
+		failure, exist := f.failures[key]
+		if !exist || failure.count < limit {
+			continue
+		}
+		if wait := failure.first.Add(window).Sub(now); wait > rtnMe {
+			rtnMe = wait
+		}
+	}
+	return rtnMe
+}
+
+// Add counts a failed login for every key.
+func (f *LoginFailures) Add(window time.Duration, keys ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// Drop the counts whose window has passed here, so keys that never fail again don't pile up.
+	now := time.Now()
+	for key, failure := range f.failures {
+		if now.Sub(failure.first) >= window {
+			delete(f.failures, key)
+		}
+	}
+
+	for _, key := range keys {
+		failure, exist := f.failures[key]
+		if !exist {
+			failure.first = now
+		}
+		failure.count++
+		f.failures[key] = failure
+	}
+}
+
+// Reset forgets the failed logins of keys, after a successful login.
+func (f *LoginFailures) Reset(keys ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, key := range keys {
+		delete(f.failures, key)
+	}
+}
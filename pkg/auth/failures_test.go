@@ -0,0 +1,41 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/auth"
+)
+
+func TestLoginFailures(t *testing.T) {
+	failures := auth.NewLoginFailures()
+
+	failures.Add(time.Hour, "user:admin", "addr:10.0.0.1")
+	if wait := failures.Blocked(2, time.Hour, "user:admin", "addr:10.0.0.1"); wait != 0 {
+		t.Errorf("one failure: blocked for %s", wait)
+	}
+
+	failures.Add(time.Hour, "user:other", "addr:10.0.0.1")
+	if wait := failures.Blocked(2, time.Hour, "user:admin", "addr:10.0.0.1"); wait <= 0 || wait > time.Hour {
+		t.Errorf("two failures from one address: blocked for %s, want up to an hour", wait)
+	}
+	if wait := failures.Blocked(2, time.Hour, "user:admin", "addr:10.0.0.2"); wait != 0 {
+		t.Errorf("another address: blocked for %s", wait)
+	}
+	if wait := failures.Blocked(0, time.Hour, "addr:10.0.0.1"); wait != 0 {
+		t.Errorf("limit 0: blocked for %s", wait)
+	}
+
+	failures.Reset("addr:10.0.0.1")
+	if wait := failures.Blocked(2, time.Hour, "addr:10.0.0.1"); wait != 0 {
+		t.Errorf("after Reset: blocked for %s", wait)
+	}
+
+	// Failures older than the window don't count.
+	failures.Add(time.Millisecond, "user:admin")
+	failures.Add(time.Millisecond, "user:admin")
+	time.Sleep(2 * time.Millisecond)
+	if wait := failures.Blocked(2, time.Millisecond, "user:admin"); wait != 0 {
+		t.Errorf("after the window: blocked for %s", wait)
+	}
+}
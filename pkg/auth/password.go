@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var ErrUnknownHash = errors.New("not a bcrypt or argon2id hash")
+
+// dummyBcrypt has a bcrypt hash per cost, each generated the first time DummyHash needs it.
+var dummyBcrypt sync.Map
+
+// CheckHash returns an error if hash isn't a bcrypt or argon2id hash VerifyPassword can check.
+func CheckHash(hash string) error {
+	if strings.HasPrefix(hash, "$argon2id$") {
+		_, err := parseArgon2id(hash)
+		return err
+	}
+
+	_, err := bcrypt.Cost([]byte(hash))
+	if err != nil {
+		return ErrUnknownHash
+	}
+	return nil
+}
+
+// DummyHash returns a hash that takes as long to check as like, a bcrypt or argon2id hash, to check a password
+// against when the user doesn't exist. Then a wrong name takes as long as a wrong password. Anything else than
+// a valid hash gets a bcrypt hash of the default cost.
+func DummyHash(like string) string {
+	if strings.HasPrefix(like, "$argon2id$") {
+		if parsed, err := parseArgon2id(like); err == nil {
+			// The key is derived from the password before it's compared, so a zero one takes as long.
+			return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, parsed.memory, parsed.time, parsed.threads,
+				base64.RawStdEncoding.EncodeToString(make([]byte, len(parsed.salt))),
+				base64.RawStdEncoding.EncodeToString(make([]byte, len(parsed.key))))
+		}
+	}
+
+	cost, err := bcrypt.Cost([]byte(like))
+	if err != nil {
+		cost = bcrypt.DefaultCost
+	}
+	if hash, exist := dummyBcrypt.Load(cost); exist {
+		return hash.(string)
+	}
+	hash, _ := bcrypt.GenerateFromPassword([]byte("not a password"), cost)
+	rtnMe, _ := dummyBcrypt.LoadOrStore(cost, string(hash))
+	return rtnMe.(string)
+}
+
+// VerifyPassword reports whether password matches hash, a bcrypt or argon2id hash.
+// An empty hash is checked against DummyHash and never matches.
+func VerifyPassword(hash string, password string) bool {
+	if hash == "" {
+		_ = bcrypt.CompareHashAndPassword([]byte(DummyHash("")), []byte(password))
+		return false
+	}
+
+	if strings.HasPrefix(hash, "$argon2id$") {
+		parsed, err := parseArgon2id(hash)
+		if err != nil {
+			return false
+		}
+		key := argon2.IDKey([]byte(password), parsed.salt, parsed.time, parsed.memory, parsed.threads, uint32(len(parsed.key)))
+		return subtle.ConstantTimeCompare(key, parsed.key) == 1
+	}
+
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+type argon2idHash struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+// parseArgon2id parses the PHC string format argon2 tools print. Ex. $argon2id$v=19$m=65536,t=3,p=4$salt$key
+func parseArgon2id(hash string) (argon2idHash, error) {
+	var rtnMe argon2idHash
+
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return rtnMe, ErrUnknownHash
+	}
+
+	var version int
+	_, err := fmt.Sscanf(parts[2], "v=%d", &version)
+	if err != nil || version != argon2.Version {
+		return rtnMe, fmt.Errorf("argon2id: unsupported version %q", parts[2])
+	}
+
+	_, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &rtnMe.memory, &rtnMe.time, &rtnMe.threads)
+	if err != nil || rtnMe.time == 0 || rtnMe.threads == 0 {
+		return rtnMe, fmt.Errorf("argon2id: invalid parameters %q", parts[3])
+	}
+
+	rtnMe.salt, err = base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return rtnMe, fmt.Errorf("argon2id: invalid salt: %w", err)
+	}
+	rtnMe.key, err = base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(rtnMe.key) == 0 {
+		return rtnMe, errors.New("argon2id: invalid key")
+	}
+
+	return rtnMe, nil
+}
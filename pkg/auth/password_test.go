@@ -0,0 +1,72 @@
+package auth_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/auth"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestVerifyPassword(t *testing.T) {
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("hunter2"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	salt := []byte("0123456789abcdef")
+	argonHash := fmt.Sprintf("$argon2id$v=%d$m=1024,t=1,p=1$%s$%s", argon2.Version,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(argon2.IDKey([]byte("hunter2"), salt, 1, 1024, 1, 32)))
+
+	for _, hash := range []string{string(bcryptHash), argonHash} {
+		if err = auth.CheckHash(hash); err != nil {
+			t.Errorf("CheckHash(%s) = %v", hash, err)
+		}
+		if !auth.VerifyPassword(hash, "hunter2") {
+			t.Errorf("%s: right password rejected", hash)
+		}
+		if auth.VerifyPassword(hash, "hunter3") {
+			t.Errorf("%s: wrong password accepted", hash)
+		}
+	}
+
+	for _, hash := range []string{"", "hunter2", "$argon2id$v=19$m=1024,t=0,p=1$c2FsdA$a2V5", "$argon2i$v=19$m=1024,t=1,p=1$c2FsdA$a2V5"} {
+		if auth.CheckHash(hash) == nil {
+			t.Errorf("CheckHash(%q) = nil, want an error", hash)
+		}
+		if auth.VerifyPassword(hash, "hunter2") {
+			t.Errorf("%q: password accepted", hash)
+		}
+	}
+}
+
+func TestDummyHash(t *testing.T) {
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("hunter2"), bcrypt.MinCost+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dummy := auth.DummyHash(string(bcryptHash))
+	if cost, _ := bcrypt.Cost([]byte(dummy)); cost != bcrypt.MinCost+1 {
+		t.Errorf("bcrypt: got cost %d, want %d", cost, bcrypt.MinCost+1)
+	}
+	if auth.DummyHash(string(bcryptHash)) != dummy {
+		t.Error("bcrypt: dummy hash generated again")
+	}
+
+	argonHash := "$argon2id$v=19$m=1024,t=2,p=1$c2FsdHNhbHQ$a2V5a2V5a2V5a2V5"
+	if got, want := auth.DummyHash(argonHash), "$argon2id$v=19$m=1024,t=2,p=1$AAAAAAAAAAA$AAAAAAAAAAAAAAAA"; got != want {
+		t.Errorf("argon2id: got %s, want %s", got, want)
+	}
+
+	if cost, _ := bcrypt.Cost([]byte(auth.DummyHash("not a hash"))); cost != bcrypt.DefaultCost {
+		t.Errorf("invalid hash: got cost %d, want the default", cost)
+	}
+
+	for _, hash := range []string{dummy, auth.DummyHash(argonHash)} {
+		if auth.VerifyPassword(hash, "hunter2") {
+			t.Errorf("%s: password accepted", hash)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// SessionCookie holds the session token, scripts can't read it.
+	SessionCookie = "qbp_session"
+	// CSRFCookie holds the CSRF token, the frontend reads it and sends it back in CSRFHeader.
+	CSRFCookie = "qbp_csrf"
+	CSRFHeader = "X-CSRF-Token"
+)
+
+type Session struct {
+	Token     string
+	User      string
+	CSRFToken string
+	Expires   time.Time
+}
+
+// Sessions are the logged in sessions, kept in memory.
+type Sessions struct {
+	mu       sync.Mutex
+	sessions map[string]Session
+}
+
+func NewSessions() *Sessions {
+	return &Sessions{sessions: make(map[string]Session)}
+}
+
+// Create starts a session for user that lasts ttl.
+func (s *Sessions) Create(user string, ttl time.Duration) Session {
+	rtnMe := Session{
+		Token:     rand.Text(),
+		User:      user,
+		CSRFToken: rand.Text(),
+		Expires:   time.Now().Add(ttl),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Drop the expired sessions here, so ones that are never used again don't pile up.
+	now := time.Now()
+	for token, session := range s.sessions {
+		if now.After(session.Expires) {
+			delete(s.sessions, token)
+		}
+	}
+	s.sessions[rtnMe.Token] = rtnMe
+
+	return rtnMe
+}
+
+// Get returns the session with token, if it hasn't expired.
+func (s *Sessions) Get(token string) (Session, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, exist := s.sessions[token]
+	if !exist {
+		return Session{}, false
+	}
+	if time.Now().After(session.Expires) {
+		delete(s.sessions, token)
+		return Session{}, false
+	}
+	return session, true
+}
+
+func (s *Sessions) Delete(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sessions, token)
+}
+
+// Request is the HTTP request a GraphQL operation came in, the login and logout mutations set cookies through it.
+type Request struct {
+	Writer   http.ResponseWriter
+	Request  *http.Request
+	Sessions *Sessions
+	Failures *LoginFailures
+	// Session is nil when the request isn't logged in.
+	Session *Session
+}
+
+type requestKey struct{}
+
+func WithRequest(ctx context.Context, req *Request) context.Context {
+	return context.WithValue(ctx, requestKey{}, req)
+}
+
+// RequestFrom returns the request added by WithRequest, nil if there isn't one.
+func RequestFrom(ctx context.Context) *Request {
+	rtnMe, _ := ctx.Value(requestKey{}).(*Request)
+	return rtnMe
+}
+
+// SetCookies gives the client the session and CSRF cookies for session.
+func SetCookies(w http.ResponseWriter, r *http.Request, session Session) {
+	http.SetCookie(w, newCookie(r, SessionCookie, session.Token, session.Expires, true))
+	http.SetCookie(w, newCookie(r, CSRFCookie, session.CSRFToken, session.Expires, false))
+}
+
+// ClearCookies removes the cookies set by SetCookies.
+func ClearCookies(w http.ResponseWriter, r *http.Request) {
+	for _, name := range []string{SessionCookie, CSRFCookie} {
+		cookie := newCookie(r, name, "", time.Unix(0, 0), name == SessionCookie)
+		cookie.MaxAge = -1
+		http.SetCookie(w, cookie)
+	}
+}
+
+func newCookie(r *http.Request, name string, value string, expires time.Time, httpOnly bool) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     "/",
+		Expires:  expires,
+		HttpOnly: httpOnly,
+		// Behind a TLS terminating proxy the request itself is plain HTTP.
+		Secure:   r.TLS != nil || strings.EqualFold(r.Header.Get("X-Forwarded-Proto"), "https"),
+		SameSite: http.SameSiteLaxMode,
+	}
+}
@@ -0,0 +1,27 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/auth"
+)
+
+func TestSessions(t *testing.T) {
+	sessions := auth.NewSessions()
+
+	session := sessions.Create("admin", time.Hour)
+	if got, exist := sessions.Get(session.Token); !exist || got.User != "admin" || got.CSRFToken == "" {
+		t.Errorf("Get = %+v %v", got, exist)
+	}
+
+	expired := sessions.Create("admin", -time.Second)
+	if _, exist := sessions.Get(expired.Token); exist {
+		t.Error("expired session returned")
+	}
+
+	sessions.Delete(session.Token)
+	if _, exist := sessions.Get(session.Token); exist {
+		t.Error("deleted session returned")
+	}
+}
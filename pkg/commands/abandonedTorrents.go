@@ -8,7 +8,9 @@ import (
 	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
 )
 
-type ListAbandonedTorrents struct{}
+type ListAbandonedTorrents struct {
+	TorrentFilter
+}
 
 func (d *ListAbandonedTorrents) Run(globals *Globals, ctx context.Context) error {
 
@@ -25,6 +27,10 @@ func (d *ListAbandonedTorrents) Run(globals *Globals, ctx context.Context) error
 		}
 
 		for _, torrent := range torrents {
+			if !d.Match(torrent) {
+				continue
+			}
+
 			resp2, err2 := client.GetTracker(ctx, torrent.Hash)
 			if err2 != nil {
 				return err2
@@ -40,7 +46,5 @@ func (d *ListAbandonedTorrents) Run(globals *Globals, ctx context.Context) error
 		}
 	}
 
-	handleOutputs.PrintTorrentInfo(globals.Output, deletedTorrents)
-
-	return nil
+	return handleOutputs.PrintTorrentInfo(globals.stdout(), globals.Output, deletedTorrents)
 }
@@ -0,0 +1,173 @@
+package commands_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"maps"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/commands"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/filterExpr"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/handleOutputs"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/helpers"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbFake"
+)
+
+var (
+	north *qbFake.Server
+	south *qbFake.Server
+
+	globals *commands.Globals
+)
+
+// TestMain points the registry at two fake servers. The registry is shared by every test,
+// so tests only change torrents they own.
+func TestMain(m *testing.M) {
+	north = qbFake.New(qbFake.WithTorrents(
+		qbFake.Torrent{Name: "north-first", Category: "movies"},
+		qbFake.Torrent{Name: "north-second", Category: "tv", Tags: "queue-me"},
+		qbFake.Torrent{Name: "north-third", Category: "tv"},
+	))
+	south = qbFake.New(qbFake.WithTorrents(
+		qbFake.Torrent{Name: "south-first", Category: "books"},
+	))
+
+	qbFake.Main(m, qbFake.TestConfig{
+		Endpoints: []configuration.QbLogin{north.Login("north"), south.Login("south")},
+		Setup: func(configFile string) error {
+			globals = &commands.Globals{Config: configFile, Output: "json", Stdout: io.Discard}
+			return nil
+		},
+	}, north, south)
+}
+
+func TestQueueTop(t *testing.T) {
+	cmd := commands.QueueTopCmd{TorrentFilter: commands.TorrentFilter{Tag: []string{"queue-me"}}}
+	if err := cmd.Run(globals, context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, torrent := range north.Torrents() {
+		if torrent.Name == "north-second" && torrent.Priority != 1 {
+			t.Errorf("priority = %d, want 1", torrent.Priority)
+		}
+	}
+}
+
+func TestSyncCategories(t *testing.T) {
+	cmd := commands.SyncCategoriesCmd{}
+	if err := cmd.Run(globals, context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, server := range []*qbFake.Server{north, south} {
+		categories := server.Categories()
+		for _, name := range []string{"movies", "tv", "books"} {
+			if _, exist := categories[name]; !exist {
+				t.Errorf("%s missing on %s", name, server.URL)
+			}
+		}
+	}
+}
+
+func TestExportArchive(t *testing.T) {
+	archive := filepath.Join(t.TempDir(), "torrents.zip")
+	cmd := commands.ExportCmd{
+		TorrentFilter: commands.TorrentFilter{Server: []string{"south"}},
+		Archive:       archive,
+	}
+	if err := cmd.Run(globals, context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := zip.OpenReader(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	if len(reader.File) != 1 || !strings.HasPrefix(reader.File[0].Name, "south/south-first-") {
+		names := make([]string, 0, len(reader.File))
+		for _, file := range reader.File {
+			names = append(names, file.Name)
+		}
+		t.Errorf("got files %v", names)
+	}
+}
+
+func TestConfigCheck(t *testing.T) {
+	broken := qbFake.New()
+	broken.Close()
+
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	err := qbFake.WriteConfig(configFile, north.Login("north"), broken.Login("broken"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := commands.ConfigCheckCmd{File: configFile, Offline: true, Timeout: time.Second}
+	if err = cmd.Run(globals, context.Background()); err != nil {
+		t.Errorf("offline: %v", err)
+	}
+
+	cmd.Offline = false
+	err = cmd.Run(globals, context.Background())
+	if err == nil || err.Error() != "1 of 2 endpoints failed the check" {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestListFilter(t *testing.T) {
+	expr, err := filterExpr.Parse(`category = tv and name !~ second`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	listGlobals := *globals
+	listGlobals.Stdout = &out
+
+	cmd := commands.ListCmd{TorrentFilter: commands.TorrentFilter{Filter: commands.FilterExpr{Expr: expr}}}
+	if err = cmd.Run(&listGlobals, context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	var document handleOutputs.Document[handleOutputs.Torrent]
+	if err = json.Unmarshal(out.Bytes(), &document); err != nil {
+		t.Fatal(err)
+	}
+	if len(document.Items) != 1 || document.Items[0].Name != "north-third" {
+		t.Errorf("got %+v", document.Items)
+	}
+}
+
+func TestStats(t *testing.T) {
+	var out bytes.Buffer
+	statsGlobals := *globals
+	statsGlobals.Stdout = &out
+
+	cmd := commands.StatsCmd{GroupBy: "category"}
+	if err := cmd.Run(&statsGlobals, context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	var document handleOutputs.Document[helpers.StatsGroup]
+	if err := json.Unmarshal(out.Bytes(), &document); err != nil {
+		t.Fatal(err)
+	}
+
+	counts := make(map[string]int)
+	for _, group := range document.Items {
+		counts[group.Key] = group.Count
+	}
+	if want := map[string]int{"books": 1, "movies": 1, "tv": 2}; !maps.Equal(counts, want) {
+		t.Errorf("got %v, want %v", counts, want)
+	}
+}
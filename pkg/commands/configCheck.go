@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/handleOutputs"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/helpers"
+)
+
+type ConfigCmd struct {
+	Check ConfigCheckCmd `cmd:"" help:"Validate a config file and test every endpoint in it, exits non-zero on any problem"`
+}
+
+type ConfigCheckCmd struct {
+	File    string        `arg:"" optional:"" type:"path" help:"Config file to check. Defaults to --config"`
+	Offline bool          `help:"Only validate the file, don't connect to the endpoints"`
+	Timeout time.Duration `help:"How long each endpoint gets to respond" default:"15s"`
+}
+
+// Run doesn't use MustGetConfig, the file being checked may not be the one the rest of the commands use.
+func (c *ConfigCheckCmd) Run(globals *Globals, ctx context.Context) error {
+	file := c.File
+	if file == "" {
+		file = globals.Config
+	}
+
+	cfg, err := configuration.LoadConfig(file)
+	if err != nil {
+		return err
+	}
+
+	err = cfg.Check()
+	if err != nil {
+		return err
+	}
+
+	if c.Offline {
+		_, err = fmt.Fprintf(globals.stdout(), "%s is valid\n", file)
+		return err
+	}
+
+	checks := helpers.CheckEndpoints(ctx, cfg.Endpoints, c.Timeout)
+	err = handleOutputs.PrintEndpointChecks(globals.stdout(), globals.Output, checks)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, check := range checks {
+		if check.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d endpoints failed the check", failed, len(checks))
+	}
+
+	return nil
+}
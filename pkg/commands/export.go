@@ -0,0 +1,181 @@
+package commands
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/handleOutputs"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/helpers"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+type ExportCmd struct {
+	TorrentFilter
+
+	Dir     string `help:"Directory to write the .torrent files to, one sub directory per server" default:"." type:"path"`
+	Archive string `help:"Write a single archive instead of loose files. The format is picked from the extension: .tar, .tar.gz, .tgz or .zip" type:"path"`
+}
+
+// exportWriter receives every exported .torrent file. name is relative and always uses forward slashes.
+type exportWriter interface {
+	Add(name string, content []byte) error
+	Close() error
+}
+
+func (e *ExportCmd) Run(globals *Globals, ctx context.Context) error {
+	configuration.MustGetConfig(globals.Config)
+	clients := qbClient.Registry().All()
+
+	writer, err := e.newWriter()
+	if err != nil {
+		return err
+	}
+
+	exported := make([]*qbClient.TorrentInfo, 0)
+
+	for _, client := range clients {
+		torrents, errL := client.GetTorrents(ctx)
+		if errL != nil {
+			_ = writer.Close()
+			return errL
+		}
+
+		for _, torrent := range torrents {
+			if !e.Match(torrent) {
+				continue
+			}
+
+			errL = exportTorrent(ctx, writer, client, torrent)
+			if errL != nil {
+				_ = writer.Close()
+				return errL
+			}
+			exported = append(exported, torrent)
+		}
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return err
+	}
+
+	return handleOutputs.PrintTorrentInfo(globals.stdout(), globals.Output, exported)
+}
+
+func exportTorrent(ctx context.Context, writer exportWriter, client *qbClient.Client, torrent *qbClient.TorrentInfo) error {
+	file, err := client.ExportTorrent(ctx, torrent.Hash)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return err
+	}
+
+	return writer.Add(helpers.SafeFileName(client.Name)+"/"+helpers.TorrentFileName(torrent), content)
+}
+
+func (e *ExportCmd) newWriter() (exportWriter, error) {
+	if e.Archive == "" {
+		return &dirExportWriter{root: e.Dir}, nil
+	}
+
+	archiveFile, err := os.Create(e.Archive)
+	if err != nil {
+		return nil, err
+	}
+
+	lowerName := strings.ToLower(e.Archive)
+	switch {
+	case strings.HasSuffix(lowerName, ".zip"):
+		return &zipExportWriter{file: archiveFile, zip: zip.NewWriter(archiveFile)}, nil
+	case strings.HasSuffix(lowerName, ".tar.gz"), strings.HasSuffix(lowerName, ".tgz"):
+		gz := gzip.NewWriter(archiveFile)
+		return &tarExportWriter{file: archiveFile, gzip: gz, tar: tar.NewWriter(gz)}, nil
+	case strings.HasSuffix(lowerName, ".tar"):
+		return &tarExportWriter{file: archiveFile, tar: tar.NewWriter(archiveFile)}, nil
+	default:
+		_ = archiveFile.Close()
+		_ = os.Remove(e.Archive)
+		return nil, errors.New("unknown archive format, use .tar, .tar.gz, .tgz or .zip")
+	}
+}
+
+type dirExportWriter struct {
+	root string
+}
+
+func (d *dirExportWriter) Add(name string, content []byte) error {
+	path := filepath.Join(d.root, filepath.FromSlash(name))
+	err := os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, content, 0o644)
+}
+
+func (d *dirExportWriter) Close() error {
+	return nil
+}
+
+type tarExportWriter struct {
+	file *os.File
+	gzip *gzip.Writer
+	tar  *tar.Writer
+}
+
+func (t *tarExportWriter) Add(name string, content []byte) error {
+	err := t.tar.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(content)),
+		ModTime: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = t.tar.Write(content)
+	return err
+}
+
+func (t *tarExportWriter) Close() error {
+	errs := []error{t.tar.Close()}
+	if t.gzip != nil {
+		errs = append(errs, t.gzip.Close())
+	}
+	errs = append(errs, t.file.Close())
+	return errors.Join(errs...)
+}
+
+type zipExportWriter struct {
+	file *os.File
+	zip  *zip.Writer
+}
+
+func (z *zipExportWriter) Add(name string, content []byte) error {
+	w, err := z.zip.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(content)
+	return err
+}
+
+func (z *zipExportWriter) Close() error {
+	return errors.Join(z.zip.Close(), z.file.Close())
+}
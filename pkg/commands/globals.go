@@ -1,14 +1,35 @@
 package commands
 
+import (
+	"io"
+	"os"
+)
+
 type Globals struct {
 	Config string `help:"Path to configuration file" default:"./dev.yaml" short:"c" type:"path"`
 	Output string `help:"Output format. Ex. table (default), json, csv, tsv, markdown" short:"o" default:"table" enum:"table,json,csv,tsv,markdown"`
+
+	// Stdout is where commands write their output, os.Stdout when nil.
+	Stdout io.Writer `kong:"-"`
+}
+
+func (g *Globals) stdout() io.Writer {
+	if g.Stdout == nil {
+		return os.Stdout
+	}
+	return g.Stdout
 }
 
 var CLI struct {
 	Globals
 
 	Abandoned      ListAbandonedTorrents `cmd:"" help:"List torrents that have been deleted from tracker"`
+	ConfigCmd      ConfigCmd             `cmd:"" name:"config" help:"Work with the config file"`
+	Export         ExportCmd             `cmd:"" help:"Export .torrent files from every server, to a directory or a single archive"`
 	List           ListCmd               `cmd:"" help:"List all torrents sorted by name"`
+	OutputSchema   OutputSchemaCmd       `cmd:"" name:"output-schema" help:"Print the JSON Schema of the -o json output"`
+	Prefs          PrefsCmd              `cmd:"" help:"Compare and enforce qBittorrent preferences across servers"`
+	QueueTop       QueueTopCmd           `cmd:"" help:"Move every torrent matching the filter to the top of the queue"`
+	Stats          StatsCmd              `cmd:"" help:"Count and add up torrents, optionally grouped or as a histogram"`
 	SyncCategories SyncCategoriesCmd     `cmd:"" help:"Sync categories across all qBittorrent clients"`
 }
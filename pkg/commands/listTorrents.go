@@ -8,7 +8,9 @@ import (
 	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
 )
 
-type ListCmd struct{}
+type ListCmd struct {
+	TorrentFilter
+}
 
 func (l *ListCmd) Run(globals *Globals, ctx context.Context) error {
 	configuration.MustGetConfig(globals.Config)
@@ -22,10 +24,12 @@ func (l *ListCmd) Run(globals *Globals, ctx context.Context) error {
 		if err2 != nil {
 			return err2
 		}
-		torrents = append(torrents, resp...)
+		for _, torrent := range resp {
+			if l.Match(torrent) {
+				torrents = append(torrents, torrent)
+			}
+		}
 	}
 
-	handleOutputs.PrintTorrentInfo(globals.Output, torrents)
-
-	return nil
+	return handleOutputs.PrintTorrentInfo(globals.stdout(), globals.Output, torrents)
 }
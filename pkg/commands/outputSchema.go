@@ -0,0 +1,14 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/handleOutputs"
+)
+
+type OutputSchemaCmd struct{}
+
+func (o *OutputSchemaCmd) Run(globals *Globals, ctx context.Context) error {
+	_, err := globals.stdout().Write(handleOutputs.Schema)
+	return err
+}
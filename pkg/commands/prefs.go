@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/handleOutputs"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/helpers"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+type PrefsCmd struct {
+	Diff  PrefsDiffCmd  `cmd:"" help:"Show preferences that differ from the baseline in the config"`
+	Apply PrefsApplyCmd `cmd:"" help:"Push the baseline preferences in the config to every server"`
+}
+
+var errNoPreferencesBaseline = errors.New("no preferences baseline in config")
+
+type PrefsDiffCmd struct{}
+
+func (p *PrefsDiffCmd) Run(globals *Globals, ctx context.Context) error {
+	cfg := configuration.MustGetConfig(globals.Config)
+	if len(cfg.Preferences) == 0 {
+		return errNoPreferencesBaseline
+	}
+
+	diffs, err := helpers.DiffPreferences(ctx, qbClient.Registry().All(), cfg.Preferences)
+	if err != nil {
+		return err
+	}
+
+	return handleOutputs.PrintPreferenceDiffs(globals.stdout(), globals.Output, diffs)
+}
+
+type PrefsApplyCmd struct{}
+
+// Run only sends the keys that differ, so servers already matching the baseline aren't touched.
+// The applied differences are printed afterwards.
+func (p *PrefsApplyCmd) Run(globals *Globals, ctx context.Context) error {
+	cfg := configuration.MustGetConfig(globals.Config)
+	if len(cfg.Preferences) == 0 {
+		return errNoPreferencesBaseline
+	}
+
+	diffs, err := helpers.DiffPreferences(ctx, qbClient.Registry().All(), cfg.Preferences)
+	if err != nil {
+		return err
+	}
+
+	patches := make(map[string]map[string]any)
+	for _, diff := range diffs {
+		if _, exist := patches[diff.Server]; !exist {
+			patches[diff.Server] = make(map[string]any)
+		}
+		patches[diff.Server][diff.Key] = diff.Expected
+	}
+
+	for server, patch := range patches {
+		client, exist := qbClient.Registry().Get(server)
+		if !exist {
+			return qbClient.ErrClientNotFound
+		}
+
+		slog.Debug("Applying preferences", "server", server, "keys", len(patch))
+		err = client.SetPreferences(ctx, patch)
+		if err != nil {
+			return err
+		}
+	}
+
+	return handleOutputs.PrintPreferenceDiffs(globals.stdout(), globals.Output, diffs)
+}
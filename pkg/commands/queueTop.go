@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/handleOutputs"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+type QueueTopCmd struct {
+	TorrentFilter
+}
+
+func (q *QueueTopCmd) Run(globals *Globals, ctx context.Context) error {
+	configuration.MustGetConfig(globals.Config)
+	clients := qbClient.Registry().All()
+
+	moved := make([]*qbClient.TorrentInfo, 0)
+
+	for _, client := range clients {
+		torrents, err := client.GetTorrents(ctx)
+		if err != nil {
+			return err
+		}
+
+		hashes := make([]string, 0)
+		for _, torrent := range torrents {
+			if !q.Match(torrent) {
+				continue
+			}
+			hashes = append(hashes, torrent.Hash)
+			moved = append(moved, torrent)
+		}
+
+		if len(hashes) == 0 {
+			continue
+		}
+
+		err = client.TopPriority(ctx, hashes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return handleOutputs.PrintTorrentInfo(globals.stdout(), globals.Output, moved)
+}
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/handleOutputs"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/helpers"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+type StatsCmd struct {
+	TorrentFilter
+
+	GroupBy   string    `help:"Group the torrents by server, category, tag, tracker or state" enum:"server,category,tag,tracker,state," default:""`
+	Histogram string    `help:"Print a histogram of ratio, age in days or size in GiB instead" enum:"ratio,age,size," default:""`
+	Buckets   []float64 `help:"Where the histogram buckets split. Ex. 0.5,1,2,5" sep:","`
+}
+
+func (s *StatsCmd) Run(globals *Globals, ctx context.Context) error {
+	configuration.MustGetConfig(globals.Config)
+	clients := qbClient.Registry().All()
+
+	torrents := make([]*qbClient.TorrentInfo, 0)
+
+	for _, client := range clients {
+		resp, err2 := client.GetTorrents(ctx)
+		if err2 != nil {
+			return err2
+		}
+		for _, torrent := range resp {
+			if s.Match(torrent) {
+				torrents = append(torrents, torrent)
+			}
+		}
+	}
+
+	if s.Histogram != "" {
+		buckets, err := helpers.Histogram(torrents, s.Histogram, s.Buckets, time.Now())
+		if err != nil {
+			return err
+		}
+		return handleOutputs.PrintHistogram(globals.stdout(), globals.Output, buckets)
+	}
+
+	total, groups, err := helpers.GroupStats(torrents, s.GroupBy)
+	if err != nil {
+		return err
+	}
+	return handleOutputs.PrintStatsGroups(globals.stdout(), globals.Output, total, groups)
+}
@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/alecthomas/kong"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/filterExpr"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+// TorrentFilter is embedded in commands that only act on some torrents.
+// Every flag that is set must match, an empty filter matches everything.
+type TorrentFilter struct {
+	Server   []string   `help:"Only torrents on these servers or groups" sep:","`
+	Category []string   `help:"Only torrents in these categories" sep:","`
+	Tag      []string   `help:"Only torrents with at least one of these tags" sep:","`
+	State    []string   `help:"Only torrents in these states. Ex. stalledUP, uploading, pausedDL" sep:","`
+	Name     string     `help:"Only torrents whose name contains this, case insensitive"`
+	Filter   FilterExpr `placeholder:"EXPR" help:"Only torrents matching this filter expression. Ex. 'state in (stalledUP, uploading) and ratio > 2 and added < 30d'"`
+}
+
+// FilterExpr is a --filter flag, it's parsed with the rest of the flags so a bad filter is reported before
+// any server is contacted.
+type FilterExpr struct {
+	*filterExpr.Expr
+}
+
+func (f *FilterExpr) Decode(ctx *kong.DecodeContext) error {
+	var input string
+	err := ctx.Scan.PopValueInto("filter", &input)
+	if err != nil {
+		return err
+	}
+
+	f.Expr, err = filterExpr.Parse(input)
+	return err
+}
+
+func (f *TorrentFilter) Match(torrent *qbClient.TorrentInfo) bool {
+	if len(f.Server) > 0 && !slices.ContainsFunc(f.Server, func(server string) bool {
+		return server == torrent.Client.Name || server == torrent.Client.Group || server == torrent.Client.BasePath.String()
+	}) {
+		return false
+	}
+	if len(f.Category) > 0 && !slices.Contains(f.Category, torrent.Category) {
+		return false
+	}
+	if len(f.State) > 0 && !slices.Contains(f.State, torrent.State) {
+		return false
+	}
+	if len(f.Tag) > 0 && !slices.ContainsFunc(torrent.TagList(), func(tag string) bool {
+		return slices.Contains(f.Tag, tag)
+	}) {
+		return false
+	}
+	if f.Name != "" && !strings.Contains(strings.ToLower(torrent.Name), strings.ToLower(f.Name)) {
+		return false
+	}
+	return f.Filter.Match(torrent)
+}
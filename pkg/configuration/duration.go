@@ -0,0 +1,23 @@
+package configuration
+
+import "time"
+
+// Duration is a time.Duration that can be written as a string in the config. Ex. 5s, 1m30s
+type Duration time.Duration
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	parsed, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+func (d Duration) Duration() time.Duration {
+	return time.Duration(d)
+}
@@ -0,0 +1,69 @@
+package configuration
+
+import (
+	"os"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// envReference matches ${NAME} and ${NAME:-default}. A bare $NAME is left alone so passwords can contain $.
+var envReference = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?}`)
+
+// expandEnv replaces the environment references in the values of the parsed config file. It runs after parsing,
+// so a value can hold anything, ex. # or : in a password, and references in keys and comments are left alone.
+// A reference to a variable that isn't set, and has no default, is an error.
+func (s *configSource) expandEnv() error {
+	if s.root == nil {
+		return nil
+	}
+
+	errs := make(ValidationErrors, 0)
+	expandNode(s.root, func(node *yaml.Node, name string) {
+		errs = append(errs, ValidationError{
+			File:    s.file,
+			Line:    node.Line,
+			Message: "environment variable " + name + " isn't set",
+		})
+	})
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func expandNode(node *yaml.Node, missing func(node *yaml.Node, name string)) {
+	switch node.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, child := range node.Content {
+			expandNode(child, missing)
+		}
+	case yaml.MappingNode:
+		for i := 1; i < len(node.Content); i += 2 {
+			expandNode(node.Content[i], missing)
+		}
+	case yaml.ScalarNode:
+		expanded := envReference.ReplaceAllStringFunc(node.Value, func(reference string) string {
+			match := envReference.FindStringSubmatch(reference)
+			if value, exist := os.LookupEnv(match[1]); exist {
+				return value
+			}
+			if match[2] != "" {
+				return match[3]
+			}
+			missing(node, match[1])
+			return reference
+		})
+		if expanded == node.Value {
+			return
+		}
+
+		node.Value = expanded
+		// A plain value gets the type of what it was replaced with, ex. downAfter: ${DOWN_AFTER} is a number.
+		// Quoted values stay strings.
+		if node.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle|yaml.LiteralStyle|yaml.FoldedStyle) == 0 {
+			node.Tag = ""
+		}
+	}
+}
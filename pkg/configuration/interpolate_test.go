@@ -0,0 +1,108 @@
+package configuration_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+)
+
+func TestEnvInterpolation(t *testing.T) {
+	t.Setenv("QBP_TEST_KEY", "from-env")
+	t.Setenv("QBP_TEST_GROUP", "set")
+
+	file := writeConfig(t, `endpoints:
+  - path: http://localhost:8081
+    apiKey: ${QBP_TEST_KEY}
+    name: ${QBP_TEST_UNSET:-fallback}
+    group: ${QBP_TEST_GROUP:-unused}
+  - path: http://localhost:8082
+    username: $USER
+    password: "pa$$${QBP_TEST_UNSET:-}word"
+`)
+	cfg, err := configuration.LoadConfig(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first, second := cfg.Endpoints[0], cfg.Endpoints[1]
+	if first.ApiKey.Value() != "from-env" || first.Name != "fallback" || first.Group != "set" {
+		t.Errorf("got apiKey %q name %q group %q, want from-env fallback set", first.ApiKey.Value(), first.Name, first.Group)
+	}
+	// A bare $NAME is kept, and an empty default is allowed.
+	if second.Username != "$USER" || second.Password.Value() != "pa$$word" {
+		t.Errorf("got username %q password %q, want $USER pa$$word", second.Username, second.Password.Value())
+	}
+}
+
+func TestEnvInterpolationMissing(t *testing.T) {
+	got := errorLines(t, "port: \"8080\"\nendpoints:\n  - path: ${QBP_TEST_MISSING}\n    apiKey: ${QBP_TEST_MISSING_KEY}\n")
+	want := []string{
+		"FILE:3: environment variable QBP_TEST_MISSING isn't set",
+		"FILE:4: environment variable QBP_TEST_MISSING_KEY isn't set",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %q\nwant %q", got, want)
+	}
+}
+
+func TestEnvInterpolationYamlSyntax(t *testing.T) {
+	values := []string{
+		"hunter2 #x",
+		"key: value",
+		`say "hi"`,
+		"it's",
+		"two\nlines",
+		"- item",
+	}
+
+	for _, value := range values {
+		t.Run(value, func(t *testing.T) {
+			t.Setenv("QBP_TEST_VALUE", value)
+
+			file := writeConfig(t, `endpoints:
+  - path: http://localhost:8081
+    username: ${QBP_TEST_VALUE}
+    password: "${QBP_TEST_VALUE}"
+    apiKey: '${QBP_TEST_VALUE}'
+`)
+			cfg, err := configuration.LoadConfig(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			endpoint := cfg.Endpoints[0]
+			if endpoint.Username != value || endpoint.Password.Value() != value || endpoint.ApiKey.Value() != value {
+				t.Errorf("got username %q password %q apiKey %q, want %q",
+					endpoint.Username, endpoint.Password.Value(), endpoint.ApiKey.Value(), value)
+			}
+		})
+	}
+}
+
+func TestEnvInterpolationSkipsComments(t *testing.T) {
+	file := writeConfig(t, `# apiKey: ${QBP_TEST_UNSET_IN_COMMENT}
+endpoints:
+  - path: http://localhost:8081 # ${QBP_TEST_UNSET_IN_COMMENT}
+`)
+	cfg, err := configuration.LoadConfig(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Endpoints[0].Path != "http://localhost:8081" {
+		t.Errorf("got path %q", cfg.Endpoints[0].Path)
+	}
+}
+
+func TestEnvInterpolationNumber(t *testing.T) {
+	t.Setenv("QBP_TEST_DOWN_AFTER", "7")
+
+	// A plain reference takes the type of its value, a quoted one stays a string.
+	cfg, err := configuration.LoadConfig(writeConfig(t, "port: \"${QBP_TEST_DOWN_AFTER}\"\nhealth:\n  downAfter: ${QBP_TEST_DOWN_AFTER}\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Health.DownAfter != 7 || cfg.Port != "7" {
+		t.Errorf("got downAfter %d port %q, want 7 and \"7\"", cfg.Health.DownAfter, cfg.Port)
+	}
+}
@@ -0,0 +1,99 @@
+package configuration
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// Secret is a config value that must not end up in logs or API responses.
+// It prints, logs and marshals as [REDACTED], use Value to get the real value.
+type Secret string
+
+func (s Secret) Value() string {
+	return string(s)
+}
+
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return redacted
+}
+
+func (s Secret) GoString() string {
+	return fmt.Sprintf("%q", s.String())
+}
+
+func (s Secret) LogValue() slog.Value {
+	return slog.StringValue(s.String())
+}
+
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// readSecretFile reads a secret mounted as a file. Ex. a Docker or Kubernetes secret
+// Surrounding whitespace is dropped, secret files usually end with a newline.
+func readSecretFile(path string) (Secret, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return Secret(strings.TrimSpace(string(content))), nil
+}
+
+// resolveSecrets fills ApiKey and Password from ApiKeyFile and PasswordFile.
+func (config *Config) resolveSecrets() error {
+	v := &validator{source: config.source}
+
+	for i := range config.Endpoints {
+		endpoint := &config.Endpoints[i]
+		field := fmt.Sprintf("endpoints[%d]", i)
+
+		resolveSecretFile(v, field+".apiKeyFile", endpoint.ApiKeyFile, &endpoint.ApiKey)
+		resolveSecretFile(v, field+".passwordFile", endpoint.PasswordFile, &endpoint.Password)
+	}
+
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+func resolveSecretFile(v *validator, field string, path string, secret *Secret) {
+	if path == "" {
+		return
+	}
+
+	name := strings.TrimSuffix(field[strings.LastIndex(field, ".")+1:], "File")
+	if *secret != "" {
+		v.add(field, "set %s or %sFile, not both", name, name)
+		return
+	}
+
+	value, err := readSecretFile(path)
+	if err != nil {
+		v.add(field, "%v", err)
+		return
+	}
+	*secret = value
+}
+
+// secretFiles lists every secret file the config reads, so they can be watched for changes.
+func (config *Config) secretFiles() []string {
+	rtnMe := make([]string, 0)
+	for _, endpoint := range config.Endpoints {
+		if endpoint.ApiKeyFile != "" {
+			rtnMe = append(rtnMe, endpoint.ApiKeyFile)
+		}
+		if endpoint.PasswordFile != "" {
+			rtnMe = append(rtnMe, endpoint.PasswordFile)
+		}
+	}
+	return rtnMe
+}
@@ -0,0 +1,98 @@
+package configuration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+)
+
+func TestSecretFiles(t *testing.T) {
+	dir := t.TempDir()
+	apiKeyFile, passwordFile := filepath.Join(dir, "api_key"), filepath.Join(dir, "password")
+	if err := os.WriteFile(apiKeyFile, []byte("key-from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(passwordFile, []byte("  password-from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	file := writeConfig(t, fmt.Sprintf(`endpoints:
+  - path: http://localhost:8081
+    apiKeyFile: %s
+  - path: http://localhost:8082
+    username: admin
+    passwordFile: %s
+`, apiKeyFile, passwordFile))
+	cfg, err := configuration.LoadConfig(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.Endpoints[0].ApiKey.Value(); got != "key-from-file" {
+		t.Errorf("apiKey = %q, want key-from-file", got)
+	}
+	if got := cfg.Endpoints[1].Password.Value(); got != "password-from-file" {
+		t.Errorf("password = %q, want password-from-file", got)
+	}
+	if err = cfg.Check(); err != nil {
+		t.Errorf("Check: %v", err)
+	}
+
+	got := errorLines(t, fmt.Sprintf(`endpoints:
+  - path: http://localhost:8081
+    apiKey: inline
+    apiKeyFile: %s
+  - path: http://localhost:8082
+    username: admin
+    passwordFile: %s
+`, apiKeyFile, filepath.Join(dir, "missing")))
+	want := []string{
+		"FILE:4: endpoints[0].apiKeyFile: set apiKey or apiKeyFile, not both",
+		"FILE:7: endpoints[1].passwordFile: open " + filepath.Join(dir, "missing") + ": no such file or directory",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %q\nwant %q", got, want)
+	}
+}
+
+func TestSecretRedaction(t *testing.T) {
+	secret := configuration.Secret("hunter2")
+
+	if secret.Value() != "hunter2" {
+		t.Errorf("Value() = %q", secret.Value())
+	}
+	for _, got := range []string{secret.String(), fmt.Sprint(secret), fmt.Sprintf("%+v", struct{ Key configuration.Secret }{secret})} {
+		if strings.Contains(got, "hunter2") || !strings.Contains(got, "[REDACTED]") {
+			t.Errorf("got %q, want it redacted", got)
+		}
+	}
+	if got := fmt.Sprintf("%#v", secret); got != `"[REDACTED]"` {
+		t.Errorf("GoString = %s", got)
+	}
+
+	var logs bytes.Buffer
+	slog.New(slog.NewJSONHandler(&logs, nil)).Info("login", "password", secret)
+	if strings.Contains(logs.String(), "hunter2") || !strings.Contains(logs.String(), `"password":"[REDACTED]"`) {
+		t.Errorf("log = %s", logs.String())
+	}
+
+	content, err := json.Marshal(configuration.QbLogin{Path: "http://localhost", ApiKey: secret, Password: secret})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(content), "hunter2") {
+		t.Errorf("json = %s", content)
+	}
+
+	// An unset secret stays empty, so it doesn't look set.
+	if got := configuration.Secret("").String(); got != "" {
+		t.Errorf("empty secret = %q", got)
+	}
+}
@@ -0,0 +1,372 @@
+package configuration
+
+import (
+	"cmp"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/auth"
+	"gopkg.in/yaml.v3"
+)
+
+// ValidationError is a single problem with the config. Field is the yaml path. Ex. endpoints[1].path
+// File and Line are empty when the value didn't come from the config file, ex. an environment variable.
+type ValidationError struct {
+	File    string
+	Line    int
+	Field   string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	var sb strings.Builder
+	if e.File != "" {
+		sb.WriteString(e.File)
+		if e.Line > 0 {
+			sb.WriteString(":" + strconv.Itoa(e.Line))
+		}
+		sb.WriteString(": ")
+	} else if e.Line > 0 {
+		sb.WriteString("line " + strconv.Itoa(e.Line) + ": ")
+	}
+	if e.Field != "" {
+		sb.WriteString(e.Field + ": ")
+	}
+	sb.WriteString(e.Message)
+	return sb.String()
+}
+
+// ValidationErrors is every problem found by Config.Check, one per line when printed.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	lines := make([]string, 0, len(e))
+	for _, curr := range e {
+		lines = append(lines, curr.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// configSource is the parsed config file, kept so errors can point at the line a value came from.
+type configSource struct {
+	file string
+	root *yaml.Node
+}
+
+func readConfigSource(file string, content []byte) (*configSource, error) {
+	var doc yaml.Node
+	err := yaml.Unmarshal(content, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+
+	rtnMe := &configSource{file: file}
+	if len(doc.Content) > 0 {
+		rtnMe.root = doc.Content[0]
+	}
+	return rtnMe, nil
+}
+
+var fieldSegment = regexp.MustCompile(`([^.\[\]]+)|\[(\d+)]`)
+
+// line returns the line of the value at field, or of its closest parent that is in the file.
+func (s *configSource) line(field string) int {
+	if s == nil || s.root == nil {
+		return 0
+	}
+
+	curr := s.root
+	rtnMe := 0
+	for _, match := range fieldSegment.FindAllStringSubmatch(field, -1) {
+		var next *yaml.Node
+		if match[2] != "" {
+			index, _ := strconv.Atoi(match[2])
+			if curr.Kind == yaml.SequenceNode && index < len(curr.Content) {
+				next = curr.Content[index]
+			}
+		} else if curr.Kind == yaml.MappingNode {
+			for i := 0; i+1 < len(curr.Content); i += 2 {
+				if strings.EqualFold(curr.Content[i].Value, match[1]) {
+					next = curr.Content[i+1]
+					break
+				}
+			}
+		}
+
+		if next == nil {
+			return rtnMe
+		}
+		curr = next
+		rtnMe = curr.Line
+	}
+	return rtnMe
+}
+
+type validator struct {
+	source *configSource
+	errs   ValidationErrors
+}
+
+func (v *validator) add(field string, format string, args ...any) {
+	curr := ValidationError{
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	}
+	if v.source != nil {
+		curr.File = v.source.file
+		curr.Line = v.source.line(field)
+	}
+	v.errs = append(v.errs, curr)
+}
+
+// Check validates the parts of the config that can't be fixed with a default. Every problem is returned
+// together as ValidationErrors, with the file and line when the config was loaded from a file.
+// It isn't called Validate because kong would run it while parsing, before the caller can tell a parse error apart.
+func (config *Config) Check() error {
+	v := &validator{source: config.source}
+
+	if v.source != nil && v.source.root != nil {
+		checkKeys(v, v.source.root, reflect.TypeFor[Config](), "", true)
+	}
+
+	port, err := strconv.Atoi(config.Port)
+	if err != nil || port < 1 || port > 65535 {
+		v.add("port", "%q isn't a valid port", config.Port)
+	}
+
+	switch strings.ToLower(config.Env) {
+	case "dev", "development", "prod", "production":
+	default:
+		v.add("env", "%q isn't one of development or production", config.Env)
+	}
+
+	if _, err = config.GetLogLevel(); err != nil {
+		v.add("logLevel", "%q isn't one of debug, info, warn or error", config.LogLevel)
+	}
+
+	if config.ReloadInterval < 0 {
+		v.add("reloadInterval", "can't be negative")
+	}
+
+	if config.Health.Interval <= 0 {
+		v.add("health.interval", "must be more than 0")
+	}
+	if config.Health.Timeout <= 0 {
+		v.add("health.timeout", "must be more than 0")
+	}
+	if config.Health.DownAfter < 1 {
+		v.add("health.downAfter", "must be at least 1")
+	}
+	if _, err = config.Health.RequiredHealthy(0); err != nil {
+		v.add("health.minHealthy", "%q must be a count, a percentage or all. Ex. 2, 50%%, all", config.Health.MinHealthy)
+	}
+
+	if config.GraphQL.ComplexityLimit < 0 {
+		v.add("graphql.complexityLimit", "can't be negative")
+	}
+	if config.GraphQL.DepthLimit < 0 {
+		v.add("graphql.depthLimit", "can't be negative")
+	}
+	if config.GraphQL.PersistedQueries < 0 {
+		v.add("graphql.persistedQueries", "can't be negative")
+	}
+
+	if config.Auth.SessionTTL <= 0 {
+		v.add("auth.sessionTTL", "must be more than 0")
+	}
+	if config.Auth.LoginAttempts < 0 {
+		v.add("auth.loginAttempts", "can't be negative")
+	}
+	if config.Auth.LoginWindow <= 0 {
+		v.add("auth.loginWindow", "must be more than 0")
+	}
+	users := make(map[string]int)
+	for i, user := range config.Auth.Users {
+		field := fmt.Sprintf("auth.users[%d]", i)
+
+		if user.Name == "" {
+			v.add(field+".name", "is empty")
+		} else if first, exist := users[user.Name]; exist {
+			v.add(field+".name", "%s is already used by auth.users[%d]", user.Name, first)
+		} else {
+			users[user.Name] = i
+		}
+
+		if errL := auth.CheckHash(user.PasswordHash.Value()); errL != nil {
+			v.add(field+".passwordHash", "%v", errL)
+		}
+	}
+
+	seen := make(map[string]int)
+	names := make(map[string]int)
+
+	for i, endpoint := range config.Endpoints {
+		field := fmt.Sprintf("endpoints[%d]", i)
+
+		if endpoint.Path == "" {
+			v.add(field+".path", "is empty")
+			continue
+		}
+
+		parsed, errL := url.Parse(endpoint.Path)
+		if errL != nil {
+			v.add(field+".path", "%v", errL)
+			continue
+		}
+		if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			v.add(field+".path", "%q must be an http or https URL. Ex. http://localhost:8080", endpoint.Path)
+			continue
+		}
+
+		if first, exist := seen[parsed.String()]; exist {
+			v.add(field+".path", "%s is already used by endpoints[%d]", endpoint.Path, first)
+		} else {
+			seen[parsed.String()] = i
+		}
+
+		name := endpoint.GetName()
+		if first, exist := names[name]; exist {
+			v.add(field+".name", "%s is already used by endpoints[%d]", name, first)
+		} else {
+			names[name] = i
+		}
+
+		if endpoint.ApiKey != "" && endpoint.Username != "" {
+			v.add(field+".username", "use apiKey or username and password, not both")
+		}
+		if (endpoint.Username == "") != (endpoint.Password == "") {
+			v.add(field+".password", "username and password must be set together")
+		}
+
+		validateTransport(v, field+".transport", endpoint.Transport)
+	}
+
+	for i, endpoint := range config.Endpoints {
+		if _, exist := names[endpoint.Group]; exist && endpoint.Group != "" {
+			v.add(fmt.Sprintf("endpoints[%d].group", i), "%s has the same name as a server", endpoint.Group)
+		}
+	}
+
+	if len(v.errs) == 0 {
+		return nil
+	}
+	slices.SortStableFunc(v.errs, func(a, b ValidationError) int {
+		return cmp.Compare(a.Line, b.Line)
+	})
+	return v.errs
+}
+
+func validateTransport(v *validator, field string, transport Transport) {
+	durations := []struct {
+		key   string
+		value Duration
+	}{
+		{"connectTimeout", transport.ConnectTimeout},
+		{"readTimeout", transport.ReadTimeout},
+		{"retryBackoff", transport.RetryBackoff},
+		{"breakerCooldown", transport.BreakerCooldown},
+	}
+	for _, curr := range durations {
+		if curr.value < 0 {
+			v.add(field+"."+curr.key, "can't be negative")
+		}
+	}
+
+	if transport.Proxy != "" {
+		parsed, err := url.Parse(transport.Proxy)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			v.add(field+".proxy", "%q isn't a valid proxy URL. Ex. http://proxy:3128", transport.Proxy)
+		}
+	}
+
+	if (transport.TLS.CertFile == "") != (transport.TLS.KeyFile == "") {
+		v.add(field+".tls", "certFile and keyFile must be set together")
+	}
+}
+
+// checkKeys reports keys in the file that don't match a setting, they would otherwise be silently ignored.
+// Top level keys are matched the way kong matches flags, nested ones case-insensitively like encoding/json.
+func checkKeys(v *validator, node *yaml.Node, t reflect.Type, field string, topLevel bool) {
+	switch {
+	case t.Kind() == reflect.Slice && node.Kind == yaml.SequenceNode:
+		for i, item := range node.Content {
+			checkKeys(v, item, t.Elem(), fmt.Sprintf("%s[%d]", field, i), false)
+		}
+	case t.Kind() == reflect.Struct && node.Kind == yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i].Value
+			keyField := key
+			if field != "" {
+				keyField = field + "." + key
+			}
+
+			fieldType, ok := findField(t, key, topLevel)
+			if !ok {
+				message := "unknown setting"
+				if topLevel {
+					if name, found := flagNameForYamlKey(t, key); found {
+						message = "unknown setting, use " + name
+					}
+				}
+				v.errs = append(v.errs, ValidationError{
+					File:    v.source.file,
+					Line:    node.Content[i].Line,
+					Field:   keyField,
+					Message: message,
+				})
+				continue
+			}
+			checkKeys(v, node.Content[i+1], fieldType, keyField, false)
+		}
+	}
+}
+
+func findField(t reflect.Type, key string, topLevel bool) (reflect.Type, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		curr := t.Field(i)
+		if !curr.IsExported() {
+			continue
+		}
+
+		if topLevel {
+			name := curr.Tag.Get("name")
+			if name == "" {
+				name = kebabCase(curr.Name)
+			}
+			if key == name {
+				return curr.Type, true
+			}
+		} else if strings.EqualFold(key, curr.Name) {
+			return curr.Type, true
+		}
+	}
+	return nil, false
+}
+
+// flagNameForYamlKey finds the setting whose yaml tag is key but is read under another name.
+func flagNameForYamlKey(t reflect.Type, key string) (string, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		curr := t.Field(i)
+		if curr.Tag.Get("yaml") == key {
+			return kebabCase(curr.Name), true
+		}
+	}
+	return "", false
+}
+
+// kebabCase turns a field name into the flag name kong gives it. Ex. FrontEndPath -> front-end-path
+func kebabCase(name string) string {
+	var sb strings.Builder
+	for i, r := range name {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			sb.WriteByte('-')
+		}
+		sb.WriteRune(r)
+	}
+	return strings.ToLower(sb.String())
+}
@@ -0,0 +1,158 @@
+package configuration_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+)
+
+// writeConfig writes content to a config file in a temporary directory and returns its path.
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+// errorLines loads and checks the config file with content, and returns its errors with the file name as FILE.
+func errorLines(t *testing.T, content string) []string {
+	t.Helper()
+
+	file := writeConfig(t, content)
+	cfg, err := configuration.LoadConfig(file)
+	if err == nil {
+		err = cfg.Check()
+	}
+	if err == nil {
+		return nil
+	}
+
+	var errs configuration.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("got %v, want ValidationErrors", err)
+	}
+	return strings.Split(strings.ReplaceAll(errs.Error(), file, "FILE"), "\n")
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name:    "valid",
+			content: "port: \"8080\"\nendpoints:\n  - path: http://localhost:8081\n    apiKey: key\n",
+		},
+		{
+			name:    "unknown top level key",
+			content: "port: \"8080\"\nlisten: 0.0.0.0\n",
+			want:    []string{"FILE:2: listen: unknown setting"},
+		},
+		{
+			name:    "yaml key read under another name",
+			content: "front_end_path: ./dist\n",
+			want:    []string{"FILE:1: front_end_path: unknown setting, use front-end-path"},
+		},
+		{
+			name:    "unknown nested key",
+			content: "endpoints:\n  - path: http://localhost:8081\n    apikey: key\n    passwd: secret\n",
+			want:    []string{"FILE:4: endpoints[0].passwd: unknown setting"},
+		},
+		{
+			name:    "bad values point at their line",
+			content: "port: \"8080\"\nenv: staging\nhealth:\n  downAfter: 0\n",
+			want: []string{
+				`FILE:2: env: "staging" isn't one of development or production`,
+				"FILE:4: health.downAfter: must be at least 1",
+			},
+		},
+		{
+			name:    "duplicate paths",
+			content: "endpoints:\n  - path: http://localhost:8081\n    name: one\n  - path: http://localhost:8081\n    name: two\n",
+			want:    []string{"FILE:4: endpoints[1].path: http://localhost:8081 is already used by endpoints[0]"},
+		},
+		{
+			name:    "duplicate names",
+			content: "endpoints:\n  - path: http://localhost:8081\n    name: box\n  - path: http://localhost:8082\n    name: box\n",
+			want:    []string{"FILE:5: endpoints[1].name: box is already used by endpoints[0]"},
+		},
+		{
+			name: "duplicate names from the host",
+			// The second name isn't in the file, the error points at the endpoint.
+			content: "endpoints:\n  - path: http://localhost:8081\n    name: localhost:8082\n  - path: http://localhost:8082\n",
+			want:    []string{"FILE:4: endpoints[1].name: localhost:8082 is already used by endpoints[0]"},
+		},
+		{
+			name:    "group named like a server",
+			content: "endpoints:\n  - path: http://localhost:8081\n    name: box\n  - path: http://localhost:8082\n    group: box\n",
+			want:    []string{"FILE:5: endpoints[1].group: box has the same name as a server"},
+		},
+		{
+			name:    "duplicate users",
+			content: "auth:\n  users:\n    - name: admin\n      passwordHash: nope\n    - name: admin\n      passwordHash: nope\n",
+			want: []string{
+				"FILE:4: auth.users[0].passwordHash: not a bcrypt or argon2id hash",
+				"FILE:5: auth.users[1].name: admin is already used by auth.users[0]",
+				"FILE:6: auth.users[1].passwordHash: not a bcrypt or argon2id hash",
+			},
+		},
+		{
+			name:    "errors sorted by line",
+			content: "endpoints:\n  - path: localhost\nport: \"0\"\n",
+			want: []string{
+				`FILE:2: endpoints[0].path: "localhost" must be an http or https URL. Ex. http://localhost:8080`,
+				`FILE:3: port: "0" isn't a valid port`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := errorLines(t, test.content); !slices.Equal(got, test.want) {
+				t.Errorf("got %q\nwant %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCheckWithoutFile(t *testing.T) {
+	cfg, err := configuration.LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = cfg.Check(); err != nil {
+		t.Fatalf("defaults: %v", err)
+	}
+
+	// Values that didn't come from a file have no file or line.
+	cfg.Port = "http"
+	if err = cfg.Check(); err == nil || err.Error() != `port: "http" isn't a valid port` {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestValidationErrorString(t *testing.T) {
+	tests := []struct {
+		err  configuration.ValidationError
+		want string
+	}{
+		{configuration.ValidationError{File: "a.yaml", Line: 3, Field: "port", Message: "bad"}, "a.yaml:3: port: bad"},
+		{configuration.ValidationError{File: "a.yaml", Field: "port", Message: "bad"}, "a.yaml: port: bad"},
+		{configuration.ValidationError{Line: 3, Message: "bad"}, "line 3: bad"},
+		{configuration.ValidationError{Message: "bad"}, "bad"},
+	}
+	for _, test := range tests {
+		if got := test.err.Error(); got != test.want {
+			t.Errorf("got %q, want %q", got, test.want)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package configuration
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// WatchConfig reloads the config when the file, or a secret file it references, changes on disk, checked every
+// ReloadInterval, or when the process gets SIGHUP. onReload is called with the old and new config after a valid
+// config has been stored, invalid configs are logged and the current one is kept. It blocks until ctx is done.
+func WatchConfig(ctx context.Context, onReload func(old, new Config)) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	var tick <-chan time.Time
+	interval := MustGetConfig().ReloadInterval.Duration()
+	if configFilePath != "" && interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	lastHash := hashConfigFiles()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			slog.Info("Got SIGHUP, reloading config", "file", configFilePath)
+		case <-tick:
+			currHash := hashConfigFiles()
+			if currHash == nil || bytes.Equal(currHash, lastHash) {
+				continue
+			}
+			slog.Info("Config file changed, reloading", "file", configFilePath)
+		}
+
+		lastHash = hashConfigFiles()
+
+		old, cfg, err := ReloadConfig()
+		if err != nil {
+			slog.Error("Invalid config, keeping the current one", "file", configFilePath, "error", err)
+			continue
+		}
+
+		onReload(old, cfg)
+	}
+}
+
+// hashConfigFiles hashes the config file and the secret files of the current config together.
+// It returns nil when the config file can't be read, ex. while it's being replaced.
+func hashConfigFiles() []byte {
+	if configFilePath == "" {
+		return nil
+	}
+
+	content, err := os.ReadFile(configFilePath)
+	if err != nil {
+		return nil
+	}
+
+	hash := sha256.New()
+	hash.Write(content)
+	cfg := MustGetConfig()
+	for _, file := range cfg.secretFiles() {
+		// A missing secret file still changes the hash, so it's noticed once it's back.
+		secret, _ := os.ReadFile(file)
+		hash.Write([]byte(file))
+		hash.Write(secret)
+	}
+	return hash.Sum(nil)
+}
+
+// RestartRequired lists the settings that changed between old and new but only take effect after a restart.
+func RestartRequired(old, new Config) []string {
+	rtnMe := make([]string, 0)
+
+	if old.Port != new.Port {
+		rtnMe = append(rtnMe, "port")
+	}
+	if old.FrontEndPath != new.FrontEndPath {
+		rtnMe = append(rtnMe, "front_end_path")
+	}
+	if old.GetEnv() != new.GetEnv() {
+		rtnMe = append(rtnMe, "env")
+	}
+	if old.ReloadInterval != new.ReloadInterval {
+		rtnMe = append(rtnMe, "reloadInterval")
+	}
+	if old.GraphQL != new.GraphQL {
+		rtnMe = append(rtnMe, "graphql")
+	}
+
+	return rtnMe
+}
@@ -0,0 +1,140 @@
+package filterExpr
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+// compile turns a comparison into a predicate, values are parsed here so a bad value is a parse error.
+func compile(f field, op string, values []token) (predicate, error) {
+	switch f.kind {
+	case kindString:
+		matches := stringMatcher(op, values)
+		return func(t *qbClient.TorrentInfo, _ time.Time) bool {
+			return matches(f.str(t))
+		}, nil
+	case kindList:
+		// !=, !~ and not in hold when no value in the list matches, the rest when any does.
+		positive := strings.TrimPrefix(strings.TrimPrefix(op, "!"), "not ")
+		negate := positive != op
+		matches := stringMatcher(positive, values)
+		return func(t *qbClient.TorrentInfo, _ time.Time) bool {
+			return slices.ContainsFunc(f.list(t), matches) != negate
+		}, nil
+	case kindNumber:
+		numbers, err := parseValues(values, parseNumber)
+		if err != nil {
+			return nil, err
+		}
+		return func(t *qbClient.TorrentInfo, _ time.Time) bool {
+			return compare(op, f.number(t), numbers)
+		}, nil
+	case kindBool:
+		booleans, err := parseValues(values, parseBool)
+		if err != nil {
+			return nil, err
+		}
+		return func(t *qbClient.TorrentInfo, _ time.Time) bool {
+			return slices.Contains(booleans, f.boolean(t)) != (op == "!=" || op == "not in")
+		}, nil
+	case kindDuration:
+		durations, err := parseValues(values, parseDuration)
+		if err != nil {
+			return nil, err
+		}
+		return func(t *qbClient.TorrentInfo, _ time.Time) bool {
+			return compare(op, f.duration(t), durations)
+		}, nil
+	case kindTime:
+		return compileTime(f, op, values[0])
+	}
+
+	panic("unknown field kind " + f.kind.String())
+}
+
+// compileTime compares against how long ago the time was for a duration, or the time itself for a date.
+// Torrents without the time, ex. completed on a torrent still downloading, never match.
+func compileTime(f field, op string, value token) (predicate, error) {
+	if age, err := parseDuration(value.value); err == nil {
+		return func(t *qbClient.TorrentInfo, now time.Time) bool {
+			when := f.time(t)
+			if when.Unix() <= 0 {
+				return false
+			}
+			return compare(op, now.Sub(when), []time.Duration{age})
+		}, nil
+	}
+
+	date, err := parseTime(value.value)
+	if err != nil {
+		return nil, &ParseError{Column: value.column, Message: value.value + " is " + err.Error()}
+	}
+	return func(t *qbClient.TorrentInfo, _ time.Time) bool {
+		when := f.time(t)
+		if when.Unix() <= 0 {
+			return false
+		}
+		return compare(op, when.Unix(), []int64{date.Unix()})
+	}, nil
+}
+
+func stringMatcher(op string, values []token) func(string) bool {
+	lower := make([]string, 0, len(values))
+	for _, value := range values {
+		lower = append(lower, strings.ToLower(value.value))
+	}
+
+	return func(s string) bool {
+		s = strings.ToLower(s)
+		switch op {
+		case "=", "in":
+			return slices.Contains(lower, s)
+		case "!=", "not in":
+			return !slices.Contains(lower, s)
+		case "~":
+			return strings.Contains(s, lower[0])
+		case "!~":
+			return !strings.Contains(s, lower[0])
+		}
+		return false
+	}
+}
+
+func parseValues[T any](values []token, parse func(string) (T, error)) ([]T, error) {
+	rtnMe := make([]T, 0, len(values))
+	for _, value := range values {
+		parsed, err := parse(value.value)
+		if err != nil {
+			return nil, &ParseError{Column: value.column, Message: value.value + " is " + err.Error()}
+		}
+		rtnMe = append(rtnMe, parsed)
+	}
+	return rtnMe, nil
+}
+
+// compare applies op to actual and the first value, in and not in check every value.
+func compare[T cmp.Ordered](op string, actual T, values []T) bool {
+	switch op {
+	case "=":
+		return actual == values[0]
+	case "!=":
+		return actual != values[0]
+	case ">":
+		return actual > values[0]
+	case ">=":
+		return actual >= values[0]
+	case "<":
+		return actual < values[0]
+	case "<=":
+		return actual <= values[0]
+	case "in":
+		return slices.Contains(values, actual)
+	case "not in":
+		return !slices.Contains(values, actual)
+	}
+	return false
+}
@@ -0,0 +1,146 @@
+package filterExpr
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+type fieldKind int
+
+const (
+	kindString fieldKind = iota
+	// kindList is a field with several values, it matches when any of them does. Ex. tag
+	kindList
+	kindNumber
+	kindBool
+	// kindTime compares against a date or, given a duration, against how long ago it was. Ex. added < 30d
+	kindTime
+	kindDuration
+)
+
+func (k fieldKind) String() string {
+	return [...]string{"text", "list", "number", "boolean", "time", "duration"}[k]
+}
+
+// operators each kind accepts, besides in and not in which every kind but kindTime accepts.
+var kindOperators = map[fieldKind][]string{
+	kindString:   {"=", "!=", "~", "!~"},
+	kindList:     {"=", "!=", "~", "!~"},
+	kindNumber:   {"=", "!=", ">", ">=", "<", "<="},
+	kindBool:     {"=", "!="},
+	kindTime:     {">", ">=", "<", "<="},
+	kindDuration: {"=", "!=", ">", ">=", "<", "<="},
+}
+
+type field struct {
+	name string
+	kind fieldKind
+
+	str      func(*qbClient.TorrentInfo) string
+	list     func(*qbClient.TorrentInfo) []string
+	number   func(*qbClient.TorrentInfo) float64
+	boolean  func(*qbClient.TorrentInfo) bool
+	time     func(*qbClient.TorrentInfo) time.Time
+	duration func(*qbClient.TorrentInfo) time.Duration
+}
+
+func stringField(name string, get func(*qbClient.TorrentInfo) string) field {
+	return field{name: name, kind: kindString, str: get}
+}
+
+func numberField[T int | int64 | float64](name string, get func(*qbClient.TorrentInfo) T) field {
+	return field{name: name, kind: kindNumber, number: func(t *qbClient.TorrentInfo) float64 { return float64(get(t)) }}
+}
+
+func timeField(name string, get func(*qbClient.TorrentInfo) time.Time) field {
+	return field{name: name, kind: kindTime, time: get}
+}
+
+// secondsField is a duration qBittorrent sends in seconds.
+func secondsField(name string, get func(*qbClient.TorrentInfo) int) field {
+	return field{name: name, kind: kindDuration, duration: func(t *qbClient.TorrentInfo) time.Duration {
+		return time.Duration(get(t)) * time.Second
+	}}
+}
+
+var fieldList = []field{
+	stringField("name", func(t *qbClient.TorrentInfo) string { return t.Name }),
+	stringField("hash", func(t *qbClient.TorrentInfo) string { return t.Hash }),
+	stringField("category", func(t *qbClient.TorrentInfo) string { return t.Category }),
+	{name: "tag", kind: kindList, list: func(t *qbClient.TorrentInfo) []string { return t.TagList() }},
+	stringField("state", func(t *qbClient.TorrentInfo) string { return t.State }),
+	stringField("tracker", func(t *qbClient.TorrentInfo) string { return t.Tracker }),
+	stringField("server", func(t *qbClient.TorrentInfo) string {
+		if t.Client == nil {
+			return ""
+		}
+		return t.Client.Name
+	}),
+	stringField("group", func(t *qbClient.TorrentInfo) string {
+		if t.Client == nil {
+			return ""
+		}
+		return t.Client.Group
+	}),
+	stringField("savePath", func(t *qbClient.TorrentInfo) string { return t.SavePath }),
+	stringField("contentPath", func(t *qbClient.TorrentInfo) string { return t.ContentPath }),
+	stringField("comment", func(t *qbClient.TorrentInfo) string { return t.Comment }),
+
+	numberField("ratio", func(t *qbClient.TorrentInfo) float64 { return t.Ratio }),
+	numberField("progress", func(t *qbClient.TorrentInfo) float64 { return t.Progress }),
+	numberField("size", func(t *qbClient.TorrentInfo) int64 { return t.Size }),
+	numberField("totalSize", func(t *qbClient.TorrentInfo) int64 { return t.TotalSize }),
+	numberField("uploaded", func(t *qbClient.TorrentInfo) int64 { return t.Uploaded }),
+	numberField("downloaded", func(t *qbClient.TorrentInfo) int64 { return t.Downloaded }),
+	numberField("upSpeed", func(t *qbClient.TorrentInfo) int { return t.Upspeed }),
+	numberField("downSpeed", func(t *qbClient.TorrentInfo) int { return t.Dlspeed }),
+	numberField("priority", func(t *qbClient.TorrentInfo) int { return t.Priority }),
+	numberField("seeds", func(t *qbClient.TorrentInfo) int { return t.NumSeeds }),
+	numberField("leeches", func(t *qbClient.TorrentInfo) int { return t.NumLeechs }),
+	numberField("availability", func(t *qbClient.TorrentInfo) float64 { return t.Availability }),
+
+	{name: "private", kind: kindBool, boolean: func(t *qbClient.TorrentInfo) bool { return t.Private }},
+
+	timeField("added", func(t *qbClient.TorrentInfo) time.Time { return t.AddedOn.Time() }),
+	timeField("completed", func(t *qbClient.TorrentInfo) time.Time { return t.CompletionOn.Time() }),
+	timeField("lastActivity", func(t *qbClient.TorrentInfo) time.Time { return time.Unix(int64(t.LastActivity), 0) }),
+
+	secondsField("seedingTime", func(t *qbClient.TorrentInfo) int { return t.SeedingTime }),
+	secondsField("timeActive", func(t *qbClient.TorrentInfo) int { return t.TimeActive }),
+	secondsField("eta", func(t *qbClient.TorrentInfo) int { return t.Eta }),
+}
+
+var fieldAliases = map[string]string{
+	"tags":        "tag",
+	"path":        "savePath",
+	"dlspeed":     "downSpeed",
+	"leechs":      "leeches",
+	"addedon":     "added",
+	"completedon": "completed",
+}
+
+// lookupField finds a field by name, case insensitive.
+func lookupField(name string) (field, bool) {
+	name = strings.ToLower(name)
+	if alias, exist := fieldAliases[name]; exist {
+		name = strings.ToLower(alias)
+	}
+
+	index := slices.IndexFunc(fieldList, func(f field) bool { return strings.ToLower(f.name) == name })
+	if index < 0 {
+		return field{}, false
+	}
+	return fieldList[index], true
+}
+
+// Fields returns the name of every field a filter can use.
+func Fields() []string {
+	rtnMe := make([]string, 0, len(fieldList))
+	for _, f := range fieldList {
+		rtnMe = append(rtnMe, f.name)
+	}
+	return rtnMe
+}
@@ -0,0 +1,274 @@
+// Package filterExpr is a small language to filter torrents. A filter is parsed once and matched against many
+// torrents, ex.
+//
+//	state in (stalledUP, uploading) and ratio > 2 and tracker ~ "example.org" and added < 30d and tag = keep
+//
+// Comparisons are combined with and, or, not and parentheses. Text is compared case insensitive and ~ means
+// contains. Numbers take size units and %, ex. size > 1.5GiB or progress < 50%. A duration against a time means
+// how long ago, so added < 30d is added in the last 30 days, a date is compared as is, ex. added > 2025-01-31.
+package filterExpr
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+// ParseError is a syntax error in a filter.
+type ParseError struct {
+	// Column is where the problem is, 1 based and counted in characters.
+	Column  int
+	Message string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid filter at column %d: %s", e.Column, e.Message)
+}
+
+type predicate func(torrent *qbClient.TorrentInfo, now time.Time) bool
+
+// Expr is a parsed filter. It's safe to use from several goroutines.
+type Expr struct {
+	source string
+	match  predicate
+	now    func() time.Time
+}
+
+// Limits on what Parse accepts. Filters come from API clients and the parser is recursive, so without them a
+// deeply nested filter overflows the stack, which crashes the process.
+const (
+	// MaxLength is the longest filter in bytes.
+	MaxLength = 4096
+	// MaxDepth is how deep parentheses and not may nest.
+	MaxDepth = 64
+)
+
+// Parse parses input. An empty input is an error, callers should skip filtering instead.
+func Parse(input string) (*Expr, error) {
+	if len(input) > MaxLength {
+		return nil, &ParseError{Column: 1, Message: fmt.Sprintf("filter is longer than %d bytes", MaxLength)}
+	}
+
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	if p.peek().typ == tokenEOF {
+		return nil, &ParseError{Column: 1, Message: "filter is empty"}
+	}
+
+	match, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if curr := p.peek(); curr.typ != tokenEOF {
+		return nil, &ParseError{Column: curr.column, Message: fmt.Sprintf("expected and, or or the end of the filter, got %s", curr.value)}
+	}
+
+	return &Expr{source: input, match: match, now: time.Now}, nil
+}
+
+// Match reports whether torrent matches the filter. A nil *Expr matches every torrent.
+func (e *Expr) Match(torrent *qbClient.TorrentInfo) bool {
+	if e == nil {
+		return true
+	}
+	return e.match(torrent, e.now())
+}
+
+func (e *Expr) String() string {
+	return e.source
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	// depth is how many parentheses and nots the parser is in.
+	depth int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	rtnMe := p.tokens[p.pos]
+	if rtnMe.typ != tokenEOF {
+		p.pos++
+	}
+	return rtnMe
+}
+
+// enter goes one level deeper for the ( or not at at, it fails past MaxDepth. Call leave once the level is parsed.
+func (p *parser) enter(at token) error {
+	p.depth++
+	if p.depth > MaxDepth {
+		return &ParseError{Column: at.column, Message: fmt.Sprintf("filter nests parentheses and not more than %d deep", MaxDepth)}
+	}
+	return nil
+}
+
+func (p *parser) leave() {
+	p.depth--
+}
+
+func (p *parser) peekKeyword(keyword string) bool {
+	curr := p.peek()
+	return curr.typ == tokenWord && strings.EqualFold(curr.value, keyword)
+}
+
+func (p *parser) parseOr() (predicate, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peekKeyword("or") {
+		p.next()
+		right, errL := p.parseAnd()
+		if errL != nil {
+			return nil, errL
+		}
+		l := left
+		left = func(t *qbClient.TorrentInfo, now time.Time) bool { return l(t, now) || right(t, now) }
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (predicate, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peekKeyword("and") {
+		p.next()
+		right, errL := p.parseNot()
+		if errL != nil {
+			return nil, errL
+		}
+		l := left
+		left = func(t *qbClient.TorrentInfo, now time.Time) bool { return l(t, now) && right(t, now) }
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (predicate, error) {
+	if !p.peekKeyword("not") {
+		return p.parsePrimary()
+	}
+
+	if err := p.enter(p.next()); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+
+	operand, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	return func(t *qbClient.TorrentInfo, now time.Time) bool { return !operand(t, now) }, nil
+}
+
+func (p *parser) parsePrimary() (predicate, error) {
+	curr := p.next()
+
+	switch curr.typ {
+	case tokenOpen:
+		if err := p.enter(curr); err != nil {
+			return nil, err
+		}
+		defer p.leave()
+
+		rtnMe, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.typ != tokenClose {
+			return nil, &ParseError{Column: closing.column, Message: fmt.Sprintf("expected ) to close the ( at column %d", curr.column)}
+		}
+		return rtnMe, nil
+	case tokenWord:
+		return p.parseComparison(curr)
+	case tokenEOF:
+		return nil, &ParseError{Column: curr.column, Message: "filter ends early, expected a comparison"}
+	default:
+		return nil, &ParseError{Column: curr.column, Message: fmt.Sprintf("expected a field name, got %s", curr.value)}
+	}
+}
+
+func (p *parser) parseComparison(name token) (predicate, error) {
+	f, exist := lookupField(name.value)
+	if !exist {
+		return nil, &ParseError{Column: name.column, Message: fmt.Sprintf("unknown field %s, use one of %s", name.value, strings.Join(Fields(), ", "))}
+	}
+
+	opToken := p.next()
+	op := opToken.value
+	switch {
+	case opToken.typ == tokenOperator:
+	case opToken.typ == tokenWord && strings.EqualFold(op, "in"):
+		op = "in"
+	case opToken.typ == tokenWord && strings.EqualFold(op, "not") && p.peekKeyword("in"):
+		p.next()
+		op = "not in"
+	default:
+		return nil, &ParseError{Column: opToken.column, Message: fmt.Sprintf("expected an operator after %s", name.value)}
+	}
+
+	allowed := kindOperators[f.kind]
+	if f.kind != kindTime {
+		allowed = append(slices.Clip(allowed), "in", "not in")
+	}
+	if !slices.Contains(allowed, op) {
+		return nil, &ParseError{Column: opToken.column, Message: fmt.Sprintf("%s is %s, use %s", f.name, f.kind, strings.Join(allowed, ", "))}
+	}
+
+	var values []token
+	if op == "in" || op == "not in" {
+		var err error
+		values, err = p.parseList()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		value := p.next()
+		if value.typ != tokenWord && value.typ != tokenString {
+			return nil, &ParseError{Column: value.column, Message: fmt.Sprintf("expected a value after %s %s", name.value, op)}
+		}
+		values = []token{value}
+	}
+
+	return compile(f, op, values)
+}
+
+// parseList reads (value, value, ...).
+func (p *parser) parseList() ([]token, error) {
+	if open := p.next(); open.typ != tokenOpen {
+		return nil, &ParseError{Column: open.column, Message: "expected ( after in"}
+	}
+
+	rtnMe := make([]token, 0)
+	for {
+		value := p.next()
+		if value.typ != tokenWord && value.typ != tokenString {
+			return nil, &ParseError{Column: value.column, Message: "expected a value in the list"}
+		}
+		rtnMe = append(rtnMe, value)
+
+		separator := p.next()
+		switch separator.typ {
+		case tokenComma:
+			continue
+		case tokenClose:
+			return rtnMe, nil
+		default:
+			return nil, &ParseError{Column: separator.column, Message: "expected , or ) in the list"}
+		}
+	}
+}
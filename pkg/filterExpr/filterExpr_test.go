@@ -0,0 +1,174 @@
+package filterExpr
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+var now = time.Date(2025, 8, 15, 12, 0, 0, 0, time.UTC)
+
+func daysAgo(days int) qbClient.JSONTime {
+	return qbClient.JSONTime(now.Add(-time.Duration(days) * 24 * time.Hour))
+}
+
+var (
+	seeding = &qbClient.TorrentInfo{
+		Common:       qbClient.Common{Client: &qbClient.Client{Name: "alpha", Group: "eu"}},
+		Name:         "Debian 13 netinst",
+		Category:     "linux",
+		Tags:         "keep, iso",
+		State:        "stalledUP",
+		Tracker:      "https://tracker.example.org/announce",
+		Ratio:        2.5,
+		Progress:     1,
+		Size:         800 << 20,
+		SeedingTime:  3 * 24 * 60 * 60,
+		AddedOn:      daysAgo(10),
+		CompletionOn: daysAgo(9),
+	}
+	downloading = &qbClient.TorrentInfo{
+		Common:       qbClient.Common{Client: &qbClient.Client{Name: "beta"}},
+		Name:         "Big Movie",
+		Category:     "movies",
+		State:        "downloading",
+		Tracker:      "udp://other.net:1337",
+		Ratio:        0.1,
+		Progress:     0.4,
+		Size:         8 << 30,
+		Private:      true,
+		AddedOn:      daysAgo(45),
+		CompletionOn: qbClient.JSONTime(time.Unix(-1, 0)),
+	}
+)
+
+func parse(t *testing.T, input string) *Expr {
+	t.Helper()
+
+	expr, err := Parse(input)
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", input, err)
+	}
+	expr.now = func() time.Time { return now }
+	return expr
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		filter      string
+		seeding     bool
+		downloading bool
+	}{
+		{`state in (stalledUP, uploading) and ratio > 2 and tracker ~ "example.org" and added < 30d and tag = keep`, true, false},
+		{`state = STALLEDUP`, true, false},
+		{`state not in (stalledUP, uploading)`, false, true},
+		{`name ~ movie`, false, true},
+		{`name !~ movie`, true, false},
+		{`name = 'Debian 13 netinst'`, true, false},
+		{`tag = iso`, true, false},
+		{`tag != keep`, false, true},
+		{`tags in (other, iso)`, true, false},
+		{`tag ~ ke`, true, false},
+		{`category = linux or category = movies`, true, true},
+		{`not category = linux`, false, true},
+		{`not (category = linux or ratio < 1)`, false, false},
+		{`ratio >= 0.1 and ratio <= 0.1`, false, true},
+		{`size > 1GiB`, false, true},
+		{`size <= 800MiB`, true, false},
+		{`progress < 50%`, false, true},
+		{`added > 30d`, false, true},
+		{`added > 2025-07-15`, true, false},
+		{`completed < 1w`, false, false},
+		{`completed < 2w`, true, false},
+		{`seedingTime >= 3d`, true, false},
+		{`seedingTime > 1d12h and seedingTime < 72h`, false, false},
+		{`private = true`, false, true},
+		{`server = ALPHA`, true, false},
+		{`group in (eu, us)`, true, false},
+		{`Ratio > 2 AND Category == linux`, true, false},
+	}
+
+	for _, test := range tests {
+		expr := parse(t, test.filter)
+		if got := expr.Match(seeding); got != test.seeding {
+			t.Errorf("%s: seeding torrent got %v", test.filter, got)
+		}
+		if got := expr.Match(downloading); got != test.downloading {
+			t.Errorf("%s: downloading torrent got %v", test.filter, got)
+		}
+	}
+}
+
+func TestPrecedence(t *testing.T) {
+	// and binds tighter than or, so this is category = movies or (category = linux and ratio > 10).
+	expr := parse(t, `category = movies or category = linux and ratio > 10`)
+	if expr.Match(seeding) || !expr.Match(downloading) {
+		t.Error("and should bind tighter than or")
+	}
+}
+
+func TestNilExprMatches(t *testing.T) {
+	var expr *Expr
+	if !expr.Match(seeding) {
+		t.Error("a nil filter should match everything")
+	}
+}
+
+func TestParseDepthLimit(t *testing.T) {
+	nested := strings.Repeat("(", MaxDepth) + "ratio > 2" + strings.Repeat(")", MaxDepth)
+	if _, err := Parse(nested); err != nil {
+		t.Errorf("%d parentheses: %v", MaxDepth, err)
+	}
+	if _, err := Parse(strings.Repeat("not ", MaxDepth) + "ratio > 2"); err != nil {
+		t.Errorf("%d nots: %v", MaxDepth, err)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		filter string
+		column int
+		msg    string
+	}{
+		{``, 1, "filter is empty"},
+		{`ratoi > 2`, 1, "unknown field ratoi"},
+		{`ratio ~ 2`, 7, "ratio is number, use =, !=, >, >=, <, <=, in, not in"},
+		{`ratio > abc`, 9, "abc is not a number"},
+		{`size > 2XB`, 8, "unknown unit XB"},
+		{`added = 30d`, 7, "added is time"},
+		{`added < yesterday`, 9, "yesterday is not a date or duration"},
+		{`seedingTime > 3`, 15, "3 is not a duration"},
+		{`private = maybe`, 11, "maybe is not true or false"},
+		{`name = "unterminated`, 8, "missing its closing \""},
+		{`(state = x`, 11, "expected ) to close the ( at column 1"},
+		{`state = x ratio > 2`, 11, "expected and, or or the end of the filter, got ratio"},
+		{`state x`, 7, "expected an operator after state"},
+		{`state =`, 8, "expected a value after state ="},
+		{`state in stalledUP`, 10, "expected ( after in"},
+		{`state in (a b)`, 13, "expected , or ) in the list"},
+		{`state = x and`, 14, "filter ends early"},
+		{`ratio > 2 !`, 11, "unexpected !"},
+		{`= 2`, 1, "expected a field name, got ="},
+		// The 65th ( is at column 65, the 65th not at column 1+4*64.
+		{strings.Repeat("(", 2000) + "ratio > 2" + strings.Repeat(")", 2000), 65, "more than 64 deep"},
+		{strings.Repeat("not ", 1000) + "ratio > 2", 257, "more than 64 deep"},
+		{strings.Repeat("not (", 40) + "ratio > 2" + strings.Repeat(")", 40), 5*32 + 1, "more than 64 deep"},
+		{strings.Repeat("(", 2_000_000), 1, "longer than 4096 bytes"},
+	}
+
+	for _, test := range tests {
+		_, err := Parse(test.filter)
+
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("%q: got %v, want a *ParseError", test.filter, err)
+			continue
+		}
+		if parseErr.Column != test.column || !strings.Contains(parseErr.Message, test.msg) {
+			t.Errorf("%q: got column %d %q, want column %d %q", test.filter, parseErr.Column, parseErr.Message, test.column, test.msg)
+		}
+	}
+}
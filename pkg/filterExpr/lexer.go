@@ -0,0 +1,107 @@
+package filterExpr
+
+import (
+	"strings"
+	"unicode"
+)
+
+type tokenType int
+
+const (
+	tokenEOF tokenType = iota
+	// tokenWord is anything unquoted that isn't an operator. Ex. ratio, stalledUP, 30d, 1.5GiB, example.org
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenOpen
+	tokenClose
+	tokenComma
+)
+
+type token struct {
+	typ   tokenType
+	value string
+	// column is where the token starts, 1 based and counted in runes.
+	column int
+}
+
+// operators longest first, so != isn't read as ! then =.
+var operators = []string{"!=", ">=", "<=", "!~", "==", "=", ">", "<", "~"}
+
+func lex(input string) ([]token, error) {
+	runes := []rune(input)
+	rtnMe := make([]token, 0)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		column := i + 1
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			rtnMe = append(rtnMe, token{typ: tokenOpen, value: "(", column: column})
+			i++
+		case r == ')':
+			rtnMe = append(rtnMe, token{typ: tokenClose, value: ")", column: column})
+			i++
+		case r == ',':
+			rtnMe = append(rtnMe, token{typ: tokenComma, value: ",", column: column})
+			i++
+		case r == '"' || r == '\'':
+			value, end, err := lexString(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			rtnMe = append(rtnMe, token{typ: tokenString, value: value, column: column})
+			i = end
+		case strings.ContainsRune("=!<>~", r):
+			operator := ""
+			for _, op := range operators {
+				if strings.HasPrefix(string(runes[i:]), op) {
+					operator = op
+					break
+				}
+			}
+			if operator == "" {
+				return nil, &ParseError{Column: column, Message: "unexpected " + string(r)}
+			}
+			i += len(operator)
+			if operator == "==" {
+				operator = "="
+			}
+			rtnMe = append(rtnMe, token{typ: tokenOperator, value: operator, column: column})
+		default:
+			end := i
+			for end < len(runes) && !unicode.IsSpace(runes[end]) && !strings.ContainsRune("()=!<>~,\"'", runes[end]) {
+				end++
+			}
+			rtnMe = append(rtnMe, token{typ: tokenWord, value: string(runes[i:end]), column: column})
+			i = end
+		}
+	}
+
+	return append(rtnMe, token{typ: tokenEOF, column: len(runes) + 1}), nil
+}
+
+// lexString reads the string starting at the quote runes[start]. A backslash escapes the next rune.
+func lexString(runes []rune, start int) (string, int, error) {
+	quote := runes[start]
+	var value strings.Builder
+
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			if i+1 < len(runes) {
+				i++
+				value.WriteRune(runes[i])
+			}
+		case quote:
+			return value.String(), i + 1, nil
+		default:
+			value.WriteRune(runes[i])
+		}
+	}
+
+	return "", 0, &ParseError{Column: start + 1, Message: "string is missing its closing " + string(quote)}
+}
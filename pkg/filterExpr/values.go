@@ -0,0 +1,101 @@
+package filterExpr
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var sizeUnits = map[string]float64{
+	"b":   1,
+	"kb":  1e3,
+	"mb":  1e6,
+	"gb":  1e9,
+	"tb":  1e12,
+	"kib": 1 << 10,
+	"mib": 1 << 20,
+	"gib": 1 << 30,
+	"tib": 1 << 40,
+	// % turns a percentage into the 0 to 1 range of progress. Ex. progress >= 50%
+	"%": 0.01,
+}
+
+// parseNumber reads a number with an optional size unit or %. Ex. 2, 0.5, 700MB, 1.5GiB, 50%
+func parseNumber(value string) (float64, error) {
+	split := strings.IndexFunc(value, func(r rune) bool {
+		return !unicode.IsDigit(r) && r != '.' && r != '-' && r != '+'
+	})
+	if split < 0 {
+		split = len(value)
+	}
+
+	number, err := strconv.ParseFloat(value[:split], 64)
+	if err != nil {
+		return 0, errors.New("not a number")
+	}
+
+	unit := strings.ToLower(value[split:])
+	if unit == "" {
+		return number, nil
+	}
+	multiplier, exist := sizeUnits[unit]
+	if !exist {
+		return 0, errors.New("unknown unit " + value[split:] + ", use B, KB, MB, GB, TB, KiB, MiB, GiB, TiB or %")
+	}
+	return number * multiplier, nil
+}
+
+var durationUnits = map[rune]time.Duration{
+	's': time.Second,
+	'm': time.Minute,
+	'h': time.Hour,
+	'd': 24 * time.Hour,
+	'w': 7 * 24 * time.Hour,
+}
+
+// parseDuration reads numbers followed by s, m, h, d or w. Ex. 30d, 1d12h, 90m
+func parseDuration(value string) (time.Duration, error) {
+	var rtnMe time.Duration
+	number := ""
+
+	for _, r := range value {
+		if unicode.IsDigit(r) {
+			number += string(r)
+			continue
+		}
+
+		unit, exist := durationUnits[unicode.ToLower(r)]
+		if !exist || number == "" {
+			return 0, errors.New("not a duration, ex. 90m, 12h, 30d or 2w")
+		}
+		n, _ := strconv.Atoi(number)
+		rtnMe += time.Duration(n) * unit
+		number = ""
+	}
+
+	if number != "" || value == "" {
+		return 0, errors.New("not a duration, ex. 90m, 12h, 30d or 2w")
+	}
+	return rtnMe, nil
+}
+
+func parseBool(value string) (bool, error) {
+	rtnMe, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.New("not true or false")
+	}
+	return rtnMe, nil
+}
+
+// parseTime reads a date or RFC 3339 time. Dates are midnight in the local time zone.
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.ParseInLocation(time.DateOnly, value, time.Local); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, errors.New("not a date or duration, ex. 2025-01-31, 2025-01-31T12:00:00Z or 30d")
+}
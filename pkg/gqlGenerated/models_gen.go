@@ -2,6 +2,26 @@
 
 package gqlGenerated
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+type AddServerArgs struct {
+	Name   *string `json:"Name,omitempty"`
+	Group  *string `json:"Group,omitempty"`
+	Path   string  `json:"Path"`
+	APIKey string  `json:"ApiKey"`
+}
+
+type AuthStatus struct {
+	Enabled bool    `json:"Enabled"`
+	User    *string `json:"User,omitempty"`
+}
+
 type Category struct {
 	Name    string   `json:"Name"`
 	Path    string   `json:"Path"`
@@ -24,12 +44,16 @@ type DeleteTorrentInfo struct {
 }
 
 type DeleteTorrentsArgs struct {
-	Torrents    []*DeleteTorrentInfo `json:"Torrents"`
+	Torrents    []*DeleteTorrentInfo `json:"Torrents,omitempty"`
 	DeleteFiles bool                 `json:"DeleteFiles"`
+	Filter      *TorrentFilter       `json:"Filter,omitempty"`
+	Atomic      bool                 `json:"Atomic"`
+	DryRun      bool                 `json:"DryRun"`
 }
 
 type DeleteTorrentsResults struct {
-	Success bool `json:"Success"`
+	Success bool                    `json:"Success"`
+	Results []TorrentMutationResult `json:"Results"`
 }
 
 type File struct {
@@ -43,59 +67,378 @@ type File struct {
 	SizeBytes    int64   `json:"SizeBytes"`
 }
 
+type HistogramBucket struct {
+	Label     string   `json:"Label"`
+	Min       *float64 `json:"Min,omitempty"`
+	Max       *float64 `json:"Max,omitempty"`
+	Count     int      `json:"Count"`
+	SizeBytes int64    `json:"SizeBytes"`
+}
+
+type LimitTorrentInfo struct {
+	Server string `json:"Server"`
+	Hash   string `json:"Hash"`
+}
+
+type LocationTorrentInfo struct {
+	Server string `json:"Server"`
+	Hash   string `json:"Hash"`
+}
+
+type LoginArgs struct {
+	Username string `json:"Username"`
+	Password string `json:"Password"`
+}
+
+type LoginResult struct {
+	User      string `json:"User"`
+	CsrfToken string `json:"CsrfToken"`
+}
+
+type MoveTorrentsArgs struct {
+	Torrents []*LocationTorrentInfo `json:"Torrents,omitempty"`
+	Filter   *TorrentFilter         `json:"Filter,omitempty"`
+	Atomic   bool                   `json:"Atomic"`
+	DryRun   bool                   `json:"DryRun"`
+	Location string                 `json:"Location"`
+}
+
+type MoveTorrentsInQueueArgs struct {
+	Torrents []*QueueTorrentInfo `json:"Torrents,omitempty"`
+	Move     QueueMove           `json:"Move"`
+	Filter   *TorrentFilter      `json:"Filter,omitempty"`
+	Atomic   bool                `json:"Atomic"`
+	DryRun   bool                `json:"DryRun"`
+}
+
+type MoveTorrentsInQueueResults struct {
+	Success bool                    `json:"Success"`
+	Results []TorrentMutationResult `json:"Results"`
+}
+
+type MoveTorrentsResults struct {
+	Success bool                    `json:"Success"`
+	Results []TorrentMutationResult `json:"Results"`
+}
+
 type Mutation struct {
 }
 
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
+}
+
 type PauseTorrentInfo struct {
 	Server string `json:"Server"`
 	Hash   string `json:"Hash"`
 }
 
 type PauseTorrentsArgs struct {
-	Torrents []*PauseTorrentInfo `json:"Torrents"`
+	Torrents []*PauseTorrentInfo `json:"Torrents,omitempty"`
+	Filter   *TorrentFilter      `json:"Filter,omitempty"`
+	Atomic   bool                `json:"Atomic"`
+	DryRun   bool                `json:"DryRun"`
 }
 
 type PauseTorrentsResults struct {
-	Success bool `json:"Success"`
+	Success bool                    `json:"Success"`
+	Results []TorrentMutationResult `json:"Results"`
+}
+
+type Preferences struct {
+	Server                   string  `json:"Server"`
+	SavePath                 string  `json:"SavePath"`
+	TempPathEnabled          bool    `json:"TempPathEnabled"`
+	TempPath                 string  `json:"TempPath"`
+	AutoTmmEnabled           bool    `json:"AutoTmmEnabled"`
+	ListenPort               int     `json:"ListenPort"`
+	RandomPort               bool    `json:"RandomPort"`
+	Upnp                     bool    `json:"Upnp"`
+	Encryption               int     `json:"Encryption"`
+	AnonymousMode            bool    `json:"AnonymousMode"`
+	Dht                      bool    `json:"Dht"`
+	Pex                      bool    `json:"Pex"`
+	Lsd                      bool    `json:"Lsd"`
+	QueueingEnabled          bool    `json:"QueueingEnabled"`
+	MaxActiveDownloads       int     `json:"MaxActiveDownloads"`
+	MaxActiveTorrents        int     `json:"MaxActiveTorrents"`
+	MaxActiveUploads         int     `json:"MaxActiveUploads"`
+	MaxConnections           int     `json:"MaxConnections"`
+	MaxConnectionsPerTorrent int     `json:"MaxConnectionsPerTorrent"`
+	MaxUploads               int     `json:"MaxUploads"`
+	MaxUploadsPerTorrent     int     `json:"MaxUploadsPerTorrent"`
+	DownloadLimit            int     `json:"DownloadLimit"`
+	UploadLimit              int     `json:"UploadLimit"`
+	AltDownloadLimit         int     `json:"AltDownloadLimit"`
+	AltUploadLimit           int     `json:"AltUploadLimit"`
+	MaxRatioEnabled          bool    `json:"MaxRatioEnabled"`
+	MaxRatio                 float64 `json:"MaxRatio"`
+	MaxSeedingTimeEnabled    bool    `json:"MaxSeedingTimeEnabled"`
+	MaxSeedingTime           int     `json:"MaxSeedingTime"`
+	JSON                     string  `json:"Json"`
+}
+
+type PreferencesPatch struct {
+	SavePath                 *string  `json:"SavePath,omitempty"`
+	TempPathEnabled          *bool    `json:"TempPathEnabled,omitempty"`
+	TempPath                 *string  `json:"TempPath,omitempty"`
+	AutoTmmEnabled           *bool    `json:"AutoTmmEnabled,omitempty"`
+	ListenPort               *int     `json:"ListenPort,omitempty"`
+	RandomPort               *bool    `json:"RandomPort,omitempty"`
+	Upnp                     *bool    `json:"Upnp,omitempty"`
+	Encryption               *int     `json:"Encryption,omitempty"`
+	AnonymousMode            *bool    `json:"AnonymousMode,omitempty"`
+	Dht                      *bool    `json:"Dht,omitempty"`
+	Pex                      *bool    `json:"Pex,omitempty"`
+	Lsd                      *bool    `json:"Lsd,omitempty"`
+	QueueingEnabled          *bool    `json:"QueueingEnabled,omitempty"`
+	MaxActiveDownloads       *int     `json:"MaxActiveDownloads,omitempty"`
+	MaxActiveTorrents        *int     `json:"MaxActiveTorrents,omitempty"`
+	MaxActiveUploads         *int     `json:"MaxActiveUploads,omitempty"`
+	MaxConnections           *int     `json:"MaxConnections,omitempty"`
+	MaxConnectionsPerTorrent *int     `json:"MaxConnectionsPerTorrent,omitempty"`
+	MaxUploads               *int     `json:"MaxUploads,omitempty"`
+	MaxUploadsPerTorrent     *int     `json:"MaxUploadsPerTorrent,omitempty"`
+	DownloadLimit            *int     `json:"DownloadLimit,omitempty"`
+	UploadLimit              *int     `json:"UploadLimit,omitempty"`
+	AltDownloadLimit         *int     `json:"AltDownloadLimit,omitempty"`
+	AltUploadLimit           *int     `json:"AltUploadLimit,omitempty"`
+	MaxRatioEnabled          *bool    `json:"MaxRatioEnabled,omitempty"`
+	MaxRatio                 *float64 `json:"MaxRatio,omitempty"`
+	MaxSeedingTimeEnabled    *bool    `json:"MaxSeedingTimeEnabled,omitempty"`
+	MaxSeedingTime           *int     `json:"MaxSeedingTime,omitempty"`
+	JSON                     *string  `json:"Json,omitempty"`
 }
 
 type Query struct {
 }
 
+type QueueTorrentInfo struct {
+	Server string `json:"Server"`
+	Hash   string `json:"Hash"`
+}
+
+type RecheckTorrentInfo struct {
+	Server string `json:"Server"`
+	Hash   string `json:"Hash"`
+}
+
+type RecheckTorrentsArgs struct {
+	Torrents []*RecheckTorrentInfo `json:"Torrents,omitempty"`
+	Filter   *TorrentFilter        `json:"Filter,omitempty"`
+	Atomic   bool                  `json:"Atomic"`
+	DryRun   bool                  `json:"DryRun"`
+}
+
+type RecheckTorrentsResults struct {
+	Success bool                    `json:"Success"`
+	Results []TorrentMutationResult `json:"Results"`
+}
+
+type RemoveServerArgs struct {
+	Server string `json:"Server"`
+}
+
 type ResumeTorrentInfo struct {
 	Server string `json:"Server"`
 	Hash   string `json:"Hash"`
 }
 
 type ResumeTorrentsArgs struct {
-	Torrents []*ResumeTorrentInfo `json:"Torrents"`
+	Torrents []*ResumeTorrentInfo `json:"Torrents,omitempty"`
+	Filter   *TorrentFilter       `json:"Filter,omitempty"`
+	Atomic   bool                 `json:"Atomic"`
+	DryRun   bool                 `json:"DryRun"`
 }
 
 type ResumeTorrentsResults struct {
+	Success bool                    `json:"Success"`
+	Results []TorrentMutationResult `json:"Results"`
+}
+
+type Server struct {
+	Server       string        `json:"Server"`
+	URL          string        `json:"Url"`
+	Group        *string       `json:"Group,omitempty"`
+	Labels       []ServerLabel `json:"Labels"`
+	Version      string        `json:"Version"`
+	Capabilities []string      `json:"Capabilities"`
+	Available    bool          `json:"Available"`
+}
+
+type ServerLabel struct {
+	Key   string `json:"Key"`
+	Value string `json:"Value"`
+}
+
+type ServerMutationResult struct {
+	Success bool    `json:"Success"`
+	Server  *Server `json:"Server,omitempty"`
+}
+
+type SetPreferencesArgs struct {
+	Server      string            `json:"Server"`
+	Preferences *PreferencesPatch `json:"Preferences"`
+}
+
+type SetPreferencesResult struct {
 	Success bool `json:"Success"`
 }
 
+type SetTorrentLimitsArgs struct {
+	Torrents                        []*LimitTorrentInfo `json:"Torrents,omitempty"`
+	Filter                          *TorrentFilter      `json:"Filter,omitempty"`
+	Atomic                          bool                `json:"Atomic"`
+	DryRun                          bool                `json:"DryRun"`
+	DownloadLimit                   *int64              `json:"DownloadLimit,omitempty"`
+	UploadLimit                     *int64              `json:"UploadLimit,omitempty"`
+	RatioLimit                      *float64            `json:"RatioLimit,omitempty"`
+	SeedingTimeLimitMinutes         *int                `json:"SeedingTimeLimitMinutes,omitempty"`
+	InactiveSeedingTimeLimitMinutes *int                `json:"InactiveSeedingTimeLimitMinutes,omitempty"`
+}
+
+type SetTorrentLimitsResults struct {
+	Success bool                    `json:"Success"`
+	Results []TorrentMutationResult `json:"Results"`
+}
+
+type Stats struct {
+	Total     *TorrentStats     `json:"Total"`
+	Groups    []StatsGroup      `json:"Groups"`
+	Histogram []HistogramBucket `json:"Histogram"`
+}
+
+type StatsGroup struct {
+	Key   string        `json:"Key"`
+	Stats *TorrentStats `json:"Stats"`
+}
+
 type SyncAPIResults struct {
 	Categories []Category `json:"Categories,omitempty"`
 	Torrents   []Torrent  `json:"Torrents,omitempty"`
 	Trackers   []Tracker  `json:"Trackers,omitempty"`
 }
 
+type TagTorrentInfo struct {
+	Server string `json:"Server"`
+	Hash   string `json:"Hash"`
+}
+
+type TagTorrentsArgs struct {
+	Torrents []*TagTorrentInfo `json:"Torrents,omitempty"`
+	Filter   *TorrentFilter    `json:"Filter,omitempty"`
+	Atomic   bool              `json:"Atomic"`
+	DryRun   bool              `json:"DryRun"`
+	Add      []string          `json:"Add,omitempty"`
+	Remove   []string          `json:"Remove,omitempty"`
+}
+
+type TagTorrentsResults struct {
+	Success bool                    `json:"Success"`
+	Results []TorrentMutationResult `json:"Results"`
+}
+
 type Torrent struct {
-	Server     string    `json:"Server"`
-	Name       string    `json:"Name"`
-	Category   string    `json:"Category"`
-	Ratio      float64   `json:"Ratio"`
-	InfoHashV1 string    `json:"InfoHashV1"`
-	Comment    string    `json:"Comment"`
-	RootPath   string    `json:"RootPath"`
-	SavePath   string    `json:"SavePath"`
-	SizeBytes  int64     `json:"SizeBytes"`
-	Trackers   []Tracker `json:"Trackers"`
-	TrackerURL string    `json:"TrackerUrl"`
-	Files      []File    `json:"Files"`
-	AddedOn    int64     `json:"AddedOn"`
-	State      string    `json:"State"`
+	Server                          string       `json:"Server"`
+	Name                            string       `json:"Name"`
+	Category                        string       `json:"Category"`
+	Tags                            []string     `json:"Tags"`
+	State                           TorrentState `json:"State"`
+	InfoHashV1                      string       `json:"InfoHashV1"`
+	InfoHashV2                      *string      `json:"InfoHashV2,omitempty"`
+	MagnetURI                       string       `json:"MagnetUri"`
+	Comment                         string       `json:"Comment"`
+	Private                         bool         `json:"Private"`
+	HasMetadata                     bool         `json:"HasMetadata"`
+	Progress                        float64      `json:"Progress"`
+	Ratio                           float64      `json:"Ratio"`
+	Availability                    float64      `json:"Availability"`
+	Popularity                      float64      `json:"Popularity"`
+	EtaSeconds                      *int64       `json:"EtaSeconds,omitempty"`
+	DownloadSpeed                   int64        `json:"DownloadSpeed"`
+	UploadSpeed                     int64        `json:"UploadSpeed"`
+	SizeBytes                       int64        `json:"SizeBytes"`
+	TotalSizeBytes                  int64        `json:"TotalSizeBytes"`
+	CompletedBytes                  int64        `json:"CompletedBytes"`
+	AmountLeftBytes                 int64        `json:"AmountLeftBytes"`
+	UploadedBytes                   int64        `json:"UploadedBytes"`
+	DownloadedBytes                 int64        `json:"DownloadedBytes"`
+	UploadedSessionBytes            int64        `json:"UploadedSessionBytes"`
+	DownloadedSessionBytes          int64        `json:"DownloadedSessionBytes"`
+	Seeds                           int          `json:"Seeds"`
+	SeedsTotal                      int          `json:"SeedsTotal"`
+	Leeches                         int          `json:"Leeches"`
+	LeechesTotal                    int          `json:"LeechesTotal"`
+	AddedAt                         time.Time    `json:"AddedAt"`
+	CompletedAt                     *time.Time   `json:"CompletedAt,omitempty"`
+	LastActivityAt                  *time.Time   `json:"LastActivityAt,omitempty"`
+	SeenCompleteAt                  *time.Time   `json:"SeenCompleteAt,omitempty"`
+	TimeActiveSeconds               int64        `json:"TimeActiveSeconds"`
+	SeedingTimeSeconds              int64        `json:"SeedingTimeSeconds"`
+	DownloadLimit                   *int64       `json:"DownloadLimit,omitempty"`
+	UploadLimit                     *int64       `json:"UploadLimit,omitempty"`
+	RatioLimit                      float64      `json:"RatioLimit"`
+	SeedingTimeLimitMinutes         int          `json:"SeedingTimeLimitMinutes"`
+	InactiveSeedingTimeLimitMinutes int          `json:"InactiveSeedingTimeLimitMinutes"`
+	MaxRatio                        float64      `json:"MaxRatio"`
+	MaxSeedingTimeMinutes           int          `json:"MaxSeedingTimeMinutes"`
+	MaxInactiveSeedingTimeMinutes   int          `json:"MaxInactiveSeedingTimeMinutes"`
+	AutoTmm                         bool         `json:"AutoTmm"`
+	ForceStart                      bool         `json:"ForceStart"`
+	SequentialDownload              bool         `json:"SequentialDownload"`
+	FirstLastPiecePriority          bool         `json:"FirstLastPiecePriority"`
+	SuperSeeding                    bool         `json:"SuperSeeding"`
+	RootPath                        string       `json:"RootPath"`
+	SavePath                        string       `json:"SavePath"`
+	ContentPath                     string       `json:"ContentPath"`
+	DownloadPath                    string       `json:"DownloadPath"`
+	TrackerURL                      string       `json:"TrackerUrl"`
+	TrackersCount                   int          `json:"TrackersCount"`
+	ReannounceSeconds               int          `json:"ReannounceSeconds"`
+	Trackers                        []Tracker    `json:"Trackers"`
+	Files                           []File       `json:"Files"`
+	AddedOn                         int64        `json:"AddedOn"`
+	QueuePosition                   int          `json:"QueuePosition"`
+}
+
+type TorrentConnection struct {
+	Edges      []TorrentEdge `json:"edges"`
+	PageInfo   *PageInfo     `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
+}
+
+type TorrentEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *Torrent `json:"node"`
+}
+
+type TorrentFilter struct {
+	Servers    []string       `json:"Servers,omitempty"`
+	Categories []string       `json:"Categories,omitempty"`
+	Tags       []string       `json:"Tags,omitempty"`
+	States     []TorrentState `json:"States,omitempty"`
+	Trackers   []string       `json:"Trackers,omitempty"`
+	Expression *string        `json:"Expression,omitempty"`
+	Search     *string        `json:"Search,omitempty"`
+}
+
+type TorrentMutationResult struct {
+	Server    string  `json:"Server"`
+	Hash      string  `json:"Hash"`
+	Ok        bool    `json:"Ok"`
+	ErrorCode *string `json:"ErrorCode,omitempty"`
+	Message   *string `json:"Message,omitempty"`
+}
+
+type TorrentStats struct {
+	Count           int     `json:"Count"`
+	SizeBytes       int64   `json:"SizeBytes"`
+	UploadedBytes   int64   `json:"UploadedBytes"`
+	DownloadedBytes int64   `json:"DownloadedBytes"`
+	AverageRatio    float64 `json:"AverageRatio"`
 }
 
 type TorrentSyncAPIArgs struct {
@@ -112,3 +455,450 @@ type Tracker struct {
 	TimesDownloaded int    `json:"TimesDownloaded"`
 	Message         string `json:"Message"`
 }
+
+type UpdateServerCredentialsArgs struct {
+	Server string `json:"Server"`
+	APIKey string `json:"ApiKey"`
+}
+
+type QueueMove string
+
+const (
+	QueueMoveUp     QueueMove = "UP"
+	QueueMoveDown   QueueMove = "DOWN"
+	QueueMoveTop    QueueMove = "TOP"
+	QueueMoveBottom QueueMove = "BOTTOM"
+)
+
+var AllQueueMove = []QueueMove{
+	QueueMoveUp,
+	QueueMoveDown,
+	QueueMoveTop,
+	QueueMoveBottom,
+}
+
+func (e QueueMove) IsValid() bool {
+	switch e {
+	case QueueMoveUp, QueueMoveDown, QueueMoveTop, QueueMoveBottom:
+		return true
+	}
+	return false
+}
+
+func (e QueueMove) String() string {
+	return string(e)
+}
+
+func (e *QueueMove) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = QueueMove(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid QueueMove", str)
+	}
+	return nil
+}
+
+func (e QueueMove) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *QueueMove) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e QueueMove) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "ASC"
+	SortOrderDesc SortOrder = "DESC"
+)
+
+var AllSortOrder = []SortOrder{
+	SortOrderAsc,
+	SortOrderDesc,
+}
+
+func (e SortOrder) IsValid() bool {
+	switch e {
+	case SortOrderAsc, SortOrderDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortOrder) String() string {
+	return string(e)
+}
+
+func (e *SortOrder) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortOrder(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortOrder", str)
+	}
+	return nil
+}
+
+func (e SortOrder) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SortOrder) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SortOrder) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type StatsGroupBy string
+
+const (
+	StatsGroupByServer   StatsGroupBy = "SERVER"
+	StatsGroupByCategory StatsGroupBy = "CATEGORY"
+	StatsGroupByTag      StatsGroupBy = "TAG"
+	StatsGroupByTracker  StatsGroupBy = "TRACKER"
+	StatsGroupByState    StatsGroupBy = "STATE"
+)
+
+var AllStatsGroupBy = []StatsGroupBy{
+	StatsGroupByServer,
+	StatsGroupByCategory,
+	StatsGroupByTag,
+	StatsGroupByTracker,
+	StatsGroupByState,
+}
+
+func (e StatsGroupBy) IsValid() bool {
+	switch e {
+	case StatsGroupByServer, StatsGroupByCategory, StatsGroupByTag, StatsGroupByTracker, StatsGroupByState:
+		return true
+	}
+	return false
+}
+
+func (e StatsGroupBy) String() string {
+	return string(e)
+}
+
+func (e *StatsGroupBy) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StatsGroupBy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StatsGroupBy", str)
+	}
+	return nil
+}
+
+func (e StatsGroupBy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *StatsGroupBy) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e StatsGroupBy) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type StatsHistogram string
+
+const (
+	StatsHistogramRatio StatsHistogram = "RATIO"
+	StatsHistogramAge   StatsHistogram = "AGE"
+	StatsHistogramSize  StatsHistogram = "SIZE"
+)
+
+var AllStatsHistogram = []StatsHistogram{
+	StatsHistogramRatio,
+	StatsHistogramAge,
+	StatsHistogramSize,
+}
+
+func (e StatsHistogram) IsValid() bool {
+	switch e {
+	case StatsHistogramRatio, StatsHistogramAge, StatsHistogramSize:
+		return true
+	}
+	return false
+}
+
+func (e StatsHistogram) String() string {
+	return string(e)
+}
+
+func (e *StatsHistogram) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StatsHistogram(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StatsHistogram", str)
+	}
+	return nil
+}
+
+func (e StatsHistogram) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *StatsHistogram) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e StatsHistogram) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TorrentSortField string
+
+const (
+	TorrentSortFieldServer             TorrentSortField = "SERVER"
+	TorrentSortFieldName               TorrentSortField = "NAME"
+	TorrentSortFieldCategory           TorrentSortField = "CATEGORY"
+	TorrentSortFieldTags               TorrentSortField = "TAGS"
+	TorrentSortFieldState              TorrentSortField = "STATE"
+	TorrentSortFieldComment            TorrentSortField = "COMMENT"
+	TorrentSortFieldProgress           TorrentSortField = "PROGRESS"
+	TorrentSortFieldRatio              TorrentSortField = "RATIO"
+	TorrentSortFieldAvailability       TorrentSortField = "AVAILABILITY"
+	TorrentSortFieldPopularity         TorrentSortField = "POPULARITY"
+	TorrentSortFieldEtaSeconds         TorrentSortField = "ETA_SECONDS"
+	TorrentSortFieldDownloadSpeed      TorrentSortField = "DOWNLOAD_SPEED"
+	TorrentSortFieldUploadSpeed        TorrentSortField = "UPLOAD_SPEED"
+	TorrentSortFieldSizeBytes          TorrentSortField = "SIZE_BYTES"
+	TorrentSortFieldTotalSizeBytes     TorrentSortField = "TOTAL_SIZE_BYTES"
+	TorrentSortFieldAmountLeftBytes    TorrentSortField = "AMOUNT_LEFT_BYTES"
+	TorrentSortFieldUploadedBytes      TorrentSortField = "UPLOADED_BYTES"
+	TorrentSortFieldDownloadedBytes    TorrentSortField = "DOWNLOADED_BYTES"
+	TorrentSortFieldSeeds              TorrentSortField = "SEEDS"
+	TorrentSortFieldLeeches            TorrentSortField = "LEECHES"
+	TorrentSortFieldAddedOn            TorrentSortField = "ADDED_ON"
+	TorrentSortFieldCompletedAt        TorrentSortField = "COMPLETED_AT"
+	TorrentSortFieldLastActivityAt     TorrentSortField = "LAST_ACTIVITY_AT"
+	TorrentSortFieldSeenCompleteAt     TorrentSortField = "SEEN_COMPLETE_AT"
+	TorrentSortFieldTimeActiveSeconds  TorrentSortField = "TIME_ACTIVE_SECONDS"
+	TorrentSortFieldSeedingTimeSeconds TorrentSortField = "SEEDING_TIME_SECONDS"
+	TorrentSortFieldQueuePosition      TorrentSortField = "QUEUE_POSITION"
+	TorrentSortFieldRootPath           TorrentSortField = "ROOT_PATH"
+	TorrentSortFieldSavePath           TorrentSortField = "SAVE_PATH"
+	TorrentSortFieldContentPath        TorrentSortField = "CONTENT_PATH"
+	TorrentSortFieldTrackerURL         TorrentSortField = "TRACKER_URL"
+	TorrentSortFieldInfoHashV1         TorrentSortField = "INFO_HASH_V1"
+)
+
+var AllTorrentSortField = []TorrentSortField{
+	TorrentSortFieldServer,
+	TorrentSortFieldName,
+	TorrentSortFieldCategory,
+	TorrentSortFieldTags,
+	TorrentSortFieldState,
+	TorrentSortFieldComment,
+	TorrentSortFieldProgress,
+	TorrentSortFieldRatio,
+	TorrentSortFieldAvailability,
+	TorrentSortFieldPopularity,
+	TorrentSortFieldEtaSeconds,
+	TorrentSortFieldDownloadSpeed,
+	TorrentSortFieldUploadSpeed,
+	TorrentSortFieldSizeBytes,
+	TorrentSortFieldTotalSizeBytes,
+	TorrentSortFieldAmountLeftBytes,
+	TorrentSortFieldUploadedBytes,
+	TorrentSortFieldDownloadedBytes,
+	TorrentSortFieldSeeds,
+	TorrentSortFieldLeeches,
+	TorrentSortFieldAddedOn,
+	TorrentSortFieldCompletedAt,
+	TorrentSortFieldLastActivityAt,
+	TorrentSortFieldSeenCompleteAt,
+	TorrentSortFieldTimeActiveSeconds,
+	TorrentSortFieldSeedingTimeSeconds,
+	TorrentSortFieldQueuePosition,
+	TorrentSortFieldRootPath,
+	TorrentSortFieldSavePath,
+	TorrentSortFieldContentPath,
+	TorrentSortFieldTrackerURL,
+	TorrentSortFieldInfoHashV1,
+}
+
+func (e TorrentSortField) IsValid() bool {
+	switch e {
+	case TorrentSortFieldServer, TorrentSortFieldName, TorrentSortFieldCategory, TorrentSortFieldTags, TorrentSortFieldState, TorrentSortFieldComment, TorrentSortFieldProgress, TorrentSortFieldRatio, TorrentSortFieldAvailability, TorrentSortFieldPopularity, TorrentSortFieldEtaSeconds, TorrentSortFieldDownloadSpeed, TorrentSortFieldUploadSpeed, TorrentSortFieldSizeBytes, TorrentSortFieldTotalSizeBytes, TorrentSortFieldAmountLeftBytes, TorrentSortFieldUploadedBytes, TorrentSortFieldDownloadedBytes, TorrentSortFieldSeeds, TorrentSortFieldLeeches, TorrentSortFieldAddedOn, TorrentSortFieldCompletedAt, TorrentSortFieldLastActivityAt, TorrentSortFieldSeenCompleteAt, TorrentSortFieldTimeActiveSeconds, TorrentSortFieldSeedingTimeSeconds, TorrentSortFieldQueuePosition, TorrentSortFieldRootPath, TorrentSortFieldSavePath, TorrentSortFieldContentPath, TorrentSortFieldTrackerURL, TorrentSortFieldInfoHashV1:
+		return true
+	}
+	return false
+}
+
+func (e TorrentSortField) String() string {
+	return string(e)
+}
+
+func (e *TorrentSortField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TorrentSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TorrentSortField", str)
+	}
+	return nil
+}
+
+func (e TorrentSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TorrentSortField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TorrentSortField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TorrentState string
+
+const (
+	TorrentStateError              TorrentState = "error"
+	TorrentStateMissingFiles       TorrentState = "missingFiles"
+	TorrentStateUploading          TorrentState = "uploading"
+	TorrentStatePausedUp           TorrentState = "pausedUP"
+	TorrentStateStoppedUp          TorrentState = "stoppedUP"
+	TorrentStateQueuedUp           TorrentState = "queuedUP"
+	TorrentStateStalledUp          TorrentState = "stalledUP"
+	TorrentStateCheckingUp         TorrentState = "checkingUP"
+	TorrentStateForcedUp           TorrentState = "forcedUP"
+	TorrentStateAllocating         TorrentState = "allocating"
+	TorrentStateDownloading        TorrentState = "downloading"
+	TorrentStateMetaDl             TorrentState = "metaDL"
+	TorrentStateForcedMetaDl       TorrentState = "forcedMetaDL"
+	TorrentStatePausedDl           TorrentState = "pausedDL"
+	TorrentStateStoppedDl          TorrentState = "stoppedDL"
+	TorrentStateQueuedDl           TorrentState = "queuedDL"
+	TorrentStateStalledDl          TorrentState = "stalledDL"
+	TorrentStateCheckingDl         TorrentState = "checkingDL"
+	TorrentStateForcedDl           TorrentState = "forcedDL"
+	TorrentStateCheckingResumeData TorrentState = "checkingResumeData"
+	TorrentStateMoving             TorrentState = "moving"
+	TorrentStateUnknown            TorrentState = "unknown"
+)
+
+var AllTorrentState = []TorrentState{
+	TorrentStateError,
+	TorrentStateMissingFiles,
+	TorrentStateUploading,
+	TorrentStatePausedUp,
+	TorrentStateStoppedUp,
+	TorrentStateQueuedUp,
+	TorrentStateStalledUp,
+	TorrentStateCheckingUp,
+	TorrentStateForcedUp,
+	TorrentStateAllocating,
+	TorrentStateDownloading,
+	TorrentStateMetaDl,
+	TorrentStateForcedMetaDl,
+	TorrentStatePausedDl,
+	TorrentStateStoppedDl,
+	TorrentStateQueuedDl,
+	TorrentStateStalledDl,
+	TorrentStateCheckingDl,
+	TorrentStateForcedDl,
+	TorrentStateCheckingResumeData,
+	TorrentStateMoving,
+	TorrentStateUnknown,
+}
+
+func (e TorrentState) IsValid() bool {
+	switch e {
+	case TorrentStateError, TorrentStateMissingFiles, TorrentStateUploading, TorrentStatePausedUp, TorrentStateStoppedUp, TorrentStateQueuedUp, TorrentStateStalledUp, TorrentStateCheckingUp, TorrentStateForcedUp, TorrentStateAllocating, TorrentStateDownloading, TorrentStateMetaDl, TorrentStateForcedMetaDl, TorrentStatePausedDl, TorrentStateStoppedDl, TorrentStateQueuedDl, TorrentStateStalledDl, TorrentStateCheckingDl, TorrentStateForcedDl, TorrentStateCheckingResumeData, TorrentStateMoving, TorrentStateUnknown:
+		return true
+	}
+	return false
+}
+
+func (e TorrentState) String() string {
+	return string(e)
+}
+
+func (e *TorrentState) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TorrentState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TorrentState", str)
+	}
+	return nil
+}
+
+func (e TorrentState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TorrentState) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TorrentState) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,96 @@
+package gqlResolvers
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/auth"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+var (
+	errUnauthenticated = errors.New("unauthenticated")
+	errTooManyLogins   = errors.New("too many failed logins")
+)
+
+// publicFields can be selected without logging in.
+var publicFields = map[string]bool{
+	"AuthStatus": true,
+	"login":      true,
+	"logout":     true,
+	"__typename": true,
+}
+
+// RequireLogin rejects operations from requests without a session, unless they only select publicFields.
+// It does nothing while the config has no users.
+type RequireLogin struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = RequireLogin{}
+
+func (RequireLogin) ExtensionName() string {
+	return "RequireLogin"
+}
+
+func (RequireLogin) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (RequireLogin) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if !configuration.MustGetConfig().Auth.Enabled() || opCtx.Operation == nil {
+		return nil
+	}
+	if req := auth.RequestFrom(ctx); req != nil && req.Session != nil {
+		return nil
+	}
+
+	if onlyPublicFields(opCtx.Operation.SelectionSet, make(map[string]bool)) {
+		return nil
+	}
+
+	err := gqlerror.Errorf("log in first")
+	errcode.Set(err, ErrCodeUnauthenticated)
+	return err
+}
+
+// onlyPublicFields reports whether every top level field of set is in publicFields.
+func onlyPublicFields(set ast.SelectionSet, visiting map[string]bool) bool {
+	for _, selection := range set {
+		switch curr := selection.(type) {
+		case *ast.Field:
+			if !publicFields[curr.Name] {
+				return false
+			}
+		case *ast.InlineFragment:
+			if !onlyPublicFields(curr.SelectionSet, visiting) {
+				return false
+			}
+		case *ast.FragmentSpread:
+			if curr.Definition == nil || visiting[curr.Name] {
+				continue
+			}
+			visiting[curr.Name] = true
+			if !onlyPublicFields(curr.Definition.SelectionSet, visiting) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// loginFailureKeys are the keys failed logins are counted under, the user name first and then the client address.
+func loginFailureKeys(username string, r *http.Request) []string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return []string{"user:" + username, "addr:" + host}
+}
@@ -0,0 +1,85 @@
+package gqlResolvers
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/auth"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/gqlGenerated"
+)
+
+// Login is the resolver for the login field.
+func (r *mutationResolver) Login(ctx context.Context, args gqlGenerated.LoginArgs) (*gqlGenerated.LoginResult, error) {
+	config := configuration.MustGetConfig()
+	if !config.Auth.Enabled() {
+		return nil, fmt.Errorf("%w: there are no users, auth is off", errBadUserInput)
+	}
+
+	req := auth.RequestFrom(ctx)
+	if req == nil {
+		return nil, errors.New("login needs the HTTP request to set the session cookie")
+	}
+
+	failureKeys := loginFailureKeys(args.Username, req.Request)
+	if wait := req.Failures.Blocked(config.Auth.LoginAttempts, config.Auth.LoginWindow.Duration(), failureKeys...); wait > 0 {
+		slog.WarnContext(ctx, "Login refused after too many failures", "user", args.Username, "remoteAddr", req.Request.RemoteAddr)
+		return nil, fmt.Errorf("%w, try again in %s", errTooManyLogins, wait.Round(time.Second))
+	}
+
+	user, exist := config.Auth.User(args.Username)
+	hash := user.PasswordHash.Value()
+	if !exist {
+		// The password is checked even when the user doesn't exist, against a hash as slow as the first user's,
+		// so both fail in the same time.
+		hash = auth.DummyHash(config.Auth.Users[0].PasswordHash.Value())
+	}
+	if !auth.VerifyPassword(hash, args.Password) || !exist {
+		req.Failures.Add(config.Auth.LoginWindow.Duration(), failureKeys...)
+		slog.WarnContext(ctx, "Failed login", "user", args.Username, "remoteAddr", req.Request.RemoteAddr)
+		return nil, fmt.Errorf("%w: wrong username or password", errUnauthenticated)
+	}
+	// Only the user's count, a login to one account mustn't clear an address that is guessing another's.
+	req.Failures.Reset(failureKeys[0])
+
+	// A new token on every login, so one set by someone else before logging in is useless.
+	if req.Session != nil {
+		req.Sessions.Delete(req.Session.Token)
+	}
+	session := req.Sessions.Create(user.Name, config.Auth.SessionTTL.Duration())
+	auth.SetCookies(req.Writer, req.Request, session)
+	slog.InfoContext(ctx, "Logged in", "user", user.Name, "remoteAddr", req.Request.RemoteAddr)
+
+	return &gqlGenerated.LoginResult{User: session.User, CsrfToken: session.CSRFToken}, nil
+}
+
+// Logout is the resolver for the logout field.
+func (r *mutationResolver) Logout(ctx context.Context) (bool, error) {
+	req := auth.RequestFrom(ctx)
+	if req == nil || req.Session == nil {
+		return false, nil
+	}
+
+	req.Sessions.Delete(req.Session.Token)
+	auth.ClearCookies(req.Writer, req.Request)
+	return true, nil
+}
+
+// AuthStatus is the resolver for the AuthStatus field.
+func (r *queryResolver) AuthStatus(ctx context.Context) (*gqlGenerated.AuthStatus, error) {
+	config := configuration.MustGetConfig()
+	rtnMe := &gqlGenerated.AuthStatus{Enabled: config.Auth.Enabled()}
+
+	if req := auth.RequestFrom(ctx); req != nil && req.Session != nil {
+		rtnMe.User = &req.Session.User
+	}
+	return rtnMe, nil
+}
@@ -0,0 +1,126 @@
+package gqlResolvers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/filterExpr"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Error codes set in the "code" extension of errors that came from a qBittorrent server, bad arguments or a
+// missing login to the panel. UNAUTHORIZED is a server rejecting the panel, UNAUTHENTICATED the panel rejecting
+// the client and FORBIDDEN the panel refusing an operation its config doesn't allow. TOO_MANY_LOGINS is a login
+// refused after too many failures.
+const (
+	ErrCodeUnauthenticated = "UNAUTHENTICATED"
+	ErrCodeUnauthorized    = "UNAUTHORIZED"
+	ErrCodeForbidden       = "FORBIDDEN"
+	ErrCodeTooManyLogins   = "TOO_MANY_LOGINS"
+	ErrCodeNotFound        = "NOT_FOUND"
+	ErrCodeConflict        = "CONFLICT"
+	ErrCodeUnsupportedAPI  = "UNSUPPORTED_API"
+	ErrCodeUnavailable     = "SERVER_UNAVAILABLE"
+	ErrCodeUpstream        = "UPSTREAM_ERROR"
+	ErrCodeInvalidFilter   = "INVALID_FILTER"
+	ErrCodeBadUserInput    = "BAD_USER_INPUT"
+)
+
+// ErrorPresenter adds a code, for *qbClient.APIError the upstream status and endpoint, and for
+// *filterExpr.ParseError the column, to the error extensions.
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+
+	code := errorCode(err)
+	if code == "" {
+		return gqlErr
+	}
+
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = make(map[string]any)
+	}
+	gqlErr.Extensions["code"] = code
+
+	var apiErr *qbClient.APIError
+	if errors.As(err, &apiErr) {
+		gqlErr.Extensions["status"] = apiErr.StatusCode
+		gqlErr.Extensions["endpoint"] = apiErr.Endpoint
+	}
+
+	var parseErr *filterExpr.ParseError
+	if errors.As(err, &parseErr) {
+		gqlErr.Extensions["column"] = parseErr.Column
+	}
+
+	return gqlErr
+}
+
+// productionMessages replace the messages of errors from qBittorrent in production, they can have a server's
+// response body in them. Bad arguments keep theirs, the client needs them to fix the query.
+var productionMessages = map[string]string{
+	ErrCodeUnauthorized:   "a server rejected the credentials",
+	ErrCodeNotFound:       "not found",
+	ErrCodeConflict:       "conflicts with the server's state",
+	ErrCodeUnsupportedAPI: "not supported by the server's qBittorrent version",
+	ErrCodeUnavailable:    "server unavailable",
+	ErrCodeUpstream:       "the server returned an error",
+}
+
+// ProductionErrorPresenter is ErrorPresenter without internal details. Errors from qBittorrent get a generic
+// message and no endpoint, errors without a code become "internal server error". Both are logged in full.
+// Errors gqlgen raises about the query itself are kept.
+func ProductionErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := ErrorPresenter(ctx, err)
+
+	code := errorCode(err)
+	message, replace := productionMessages[code]
+	if !replace {
+		// Bad arguments, and gqlgen's errors about parsing, validating or limiting the query. Resolver errors
+		// reach the presenter wrapped in a *gqlerror.Error too, but with the resolver's error in Err.
+		var queryErr *gqlerror.Error
+		if code != "" || (errors.As(err, &queryErr) && queryErr.Err == nil) {
+			return gqlErr
+		}
+		message = "internal server error"
+	}
+
+	slog.ErrorContext(ctx, "GraphQL error", "path", gqlErr.Path.String(), "code", code, "error", err)
+	delete(gqlErr.Extensions, "endpoint")
+	gqlErr.Message = message
+	return gqlErr
+}
+
+func errorCode(err error) string {
+	var apiErr *qbClient.APIError
+	var parseErr *filterExpr.ParseError
+
+	switch {
+	case errors.Is(err, qbClient.ErrUnauthorized):
+		return ErrCodeUnauthorized
+	case errors.Is(err, qbClient.ErrNotFound), errors.Is(err, qbClient.ErrClientNotFound):
+		return ErrCodeNotFound
+	case errors.Is(err, qbClient.ErrConflict), errors.Is(err, qbClient.ErrClientExists):
+		return ErrCodeConflict
+	case errors.Is(err, qbClient.ErrUnsupportedAPI):
+		return ErrCodeUnsupportedAPI
+	case errors.Is(err, qbClient.ErrServerUnavailable):
+		return ErrCodeUnavailable
+	case errors.As(err, &apiErr):
+		return ErrCodeUpstream
+	case errors.As(err, &parseErr):
+		return ErrCodeInvalidFilter
+	case errors.Is(err, errBadUserInput):
+		return ErrCodeBadUserInput
+	case errors.Is(err, errUnauthenticated):
+		return ErrCodeUnauthenticated
+	case errors.Is(err, errForbidden):
+		return ErrCodeForbidden
+	case errors.Is(err, errTooManyLogins):
+		return ErrCodeTooManyLogins
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,112 @@
+package gqlResolvers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/gqlGenerated"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// The sizes lists are assumed to have when estimating complexity, the real sizes aren't known until the
+// servers are asked.
+const (
+	estimatedTorrents = 100
+	estimatedTrackers = 10
+	estimatedFiles    = 10
+	// requestComplexity is the cost of a field that sends a request per torrent.
+	requestComplexity = 5
+)
+
+// Complexity estimates the cost of the fields returning lists of torrents, and of their fields that send a
+// request per torrent. Every other field costs 1.
+func Complexity() gqlGenerated.ComplexityRoot {
+	var rtnMe gqlGenerated.ComplexityRoot
+
+	rtnMe.Query.Torrents = func(childComplexity int, _ []string, _ []string, _ *string, _ *string, _ *gqlGenerated.TorrentSortField, _ *gqlGenerated.SortOrder) int {
+		return 1 + childComplexity*estimatedTorrents
+	}
+	rtnMe.Query.TorrentsConnection = func(childComplexity int, _ []string, _ []string, _ *string, _ *string, _ *gqlGenerated.TorrentSortField, _ *gqlGenerated.SortOrder, first *int, _ *string, last *int, _ *string) int {
+		torrents := estimatedTorrents
+		switch {
+		case first != nil:
+			torrents = *first
+		case last != nil:
+			torrents = *last
+		}
+		return 1 + childComplexity*torrents
+	}
+	rtnMe.Torrent.Trackers = func(childComplexity int) int {
+		return requestComplexity + childComplexity*estimatedTrackers
+	}
+	rtnMe.Torrent.Files = func(childComplexity int) int {
+		return requestComplexity + childComplexity*estimatedFiles
+	}
+
+	return rtnMe
+}
+
+const errDepthLimit = "DEPTH_LIMIT_EXCEEDED"
+
+// DepthLimit rejects operations with selections nested deeper than Limit. Introspection fields aren't counted,
+// the introspection query clients send is deep and is allowed or not separately.
+type DepthLimit struct {
+	Limit int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = DepthLimit{}
+
+func (DepthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+func (DepthLimit) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (d DepthLimit) MutateOperationContext(_ context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if opCtx.Operation == nil {
+		return nil
+	}
+
+	depth := selectionDepth(opCtx.Operation.SelectionSet, make(map[string]bool))
+	if depth > d.Limit {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, d.Limit)
+		errcode.Set(err, errDepthLimit)
+		return err
+	}
+	return nil
+}
+
+// selectionDepth returns how many levels of fields set has. visiting holds the fragments being expanded, so
+// a fragment that includes itself doesn't recurse forever.
+func selectionDepth(set ast.SelectionSet, visiting map[string]bool) int {
+	rtnMe := 0
+
+	for _, selection := range set {
+		switch curr := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(curr.Name, "__") {
+				continue
+			}
+			rtnMe = max(rtnMe, 1+selectionDepth(curr.SelectionSet, visiting))
+		case *ast.InlineFragment:
+			rtnMe = max(rtnMe, selectionDepth(curr.SelectionSet, visiting))
+		case *ast.FragmentSpread:
+			if curr.Definition == nil || visiting[curr.Name] {
+				continue
+			}
+			visiting[curr.Name] = true
+			rtnMe = max(rtnMe, selectionDepth(curr.Definition.SelectionSet, visiting))
+			delete(visiting, curr.Name)
+		}
+	}
+
+	return rtnMe
+}
@@ -0,0 +1,172 @@
+package gqlResolvers_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/filterExpr"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/gqlGenerated"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/gqlResolvers"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// errorCodes returns the code extension of every error in raw.
+func errorCodes(t *testing.T, raw json.RawMessage) []string {
+	t.Helper()
+
+	var errs []struct {
+		Extensions map[string]any
+	}
+	if err := json.Unmarshal(raw, &errs); err != nil {
+		t.Fatal(err)
+	}
+
+	rtnMe := make([]string, 0, len(errs))
+	for _, err := range errs {
+		code, _ := err.Extensions["code"].(string)
+		rtnMe = append(rtnMe, code)
+	}
+	return rtnMe
+}
+
+// frontendQuery is the torrent list the frontend loads, the default limits have to allow it.
+const frontendQuery = `{ Torrents {
+	Server Name Category Ratio InfoHashV1 Comment RootPath SavePath SizeBytes TrackerUrl AddedOn State
+	Trackers { Url Status }
+	Files { Availability Index IsSeed Name PieceRange Priority Progress SizeBytes }
+} }`
+
+func TestComplexityLimit(t *testing.T) {
+	c := newClient()
+
+	raw, err := c.RawPost(frontendQuery)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The fake torrents have no files, which is an error of its own.
+	if codes := errorCodes(t, raw.Errors); slices.Contains(codes, "COMPLEXITY_LIMIT_EXCEEDED") || slices.Contains(codes, "DEPTH_LIMIT_EXCEEDED") {
+		t.Errorf("frontend query: got errors %s", raw.Errors)
+	}
+
+	raw, err = c.RawPost(`{ TorrentsConnection(first: 1000) { edges { node { Name Files { Name Progress } Trackers { Url } } } } }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if codes := errorCodes(t, raw.Errors); len(codes) != 1 || codes[0] != "COMPLEXITY_LIMIT_EXCEEDED" {
+		t.Errorf("got errors %s, want COMPLEXITY_LIMIT_EXCEEDED", raw.Errors)
+	}
+}
+
+func TestDepthLimit(t *testing.T) {
+	h := handler.New(gqlGenerated.NewExecutableSchema(gqlGenerated.Config{Resolvers: &gqlResolvers.Resolver{}}))
+	h.AddTransport(transport.POST{})
+	h.Use(extension.Introspection{})
+	h.Use(gqlResolvers.DepthLimit{Limit: 3})
+	c := client.New(h)
+
+	raw, err := c.RawPost(`{ Torrents { Trackers { Url } } __schema { types { fields { type { ofType { name } } } } } }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if raw.Errors != nil {
+		t.Errorf("depth 3: got errors %s", raw.Errors)
+	}
+
+	raw, err = c.RawPost(`{ TorrentsConnection { ...edges } } fragment edges on TorrentConnection { edges { node { Trackers { Url } } } }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if codes := errorCodes(t, raw.Errors); len(codes) != 1 || codes[0] != "DEPTH_LIMIT_EXCEEDED" {
+		t.Errorf("got errors %s, want DEPTH_LIMIT_EXCEEDED", raw.Errors)
+	}
+}
+
+func TestPersistedQuery(t *testing.T) {
+	c := newClient()
+
+	query := `{ Servers { Server } }`
+	sum := sha256.Sum256([]byte(query))
+	extensions := client.Extensions(map[string]any{
+		"persistedQuery": map[string]any{"version": 1, "sha256Hash": hex.EncodeToString(sum[:])},
+	})
+
+	raw, err := c.RawPost("", extensions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if codes := errorCodes(t, raw.Errors); len(codes) != 1 || codes[0] != "PERSISTED_QUERY_NOT_FOUND" {
+		t.Errorf("unknown hash: got errors %s, want PERSISTED_QUERY_NOT_FOUND", raw.Errors)
+	}
+
+	if raw, err = c.RawPost(query, extensions); err != nil || raw.Errors != nil {
+		t.Fatalf("register: got %v, errors %s", err, raw.Errors)
+	}
+
+	var resp struct {
+		Servers []struct{ Server string }
+	}
+	if err = c.Post("", &resp, extensions); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Servers) != 2 {
+		t.Errorf("got %+v", resp.Servers)
+	}
+}
+
+func TestProductionErrorPresenter(t *testing.T) {
+	ctx := graphql.WithPathContext(context.Background(), graphql.NewPathWithField("Torrents"))
+
+	// gqlgen wraps every resolver error with ErrorOnPath before presenting it.
+	present := func(err error) *gqlerror.Error {
+		return gqlResolvers.ProductionErrorPresenter(ctx, graphql.ErrorOnPath(ctx, err))
+	}
+
+	upstream := present(&qbClient.APIError{StatusCode: 500, Endpoint: "/api/v2/torrents/info", Body: "secret detail"})
+	if upstream.Message != "the server returned an error" || upstream.Extensions["code"] != gqlResolvers.ErrCodeUpstream {
+		t.Errorf("upstream: got %q %v", upstream.Message, upstream.Extensions)
+	}
+	if _, exist := upstream.Extensions["endpoint"]; exist {
+		t.Errorf("upstream: endpoint wasn't removed")
+	}
+
+	internal := present(errors.New(`Get "http://10.0.0.5:8080/api/v2/torrents/info": dial tcp: connection refused`))
+	if internal.Message != "internal server error" {
+		t.Errorf("internal: got %q", internal.Message)
+	}
+
+	_, parseErr := filterExpr.Parse("size >")
+	invalid := present(parseErr)
+	if invalid.Message != parseErr.Error() {
+		t.Errorf("invalid filter: got %q, want %q", invalid.Message, parseErr.Error())
+	}
+
+	// End to end, an error without a code from a resolver loses its message.
+	h := handler.New(gqlGenerated.NewExecutableSchema(gqlGenerated.Config{Resolvers: &gqlResolvers.Resolver{}}))
+	h.AddTransport(transport.POST{})
+	h.SetErrorPresenter(gqlResolvers.ProductionErrorPresenter)
+	raw, err := client.New(h).RawPost(`{ Torrent(infoHashV1: "missing") { Name } }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(raw.Errors), "internal server error") {
+		t.Errorf("end to end: got errors %s", raw.Errors)
+	}
+
+	// Errors about the query itself keep their message. The client returns them as an error, as the status is 422.
+	_, err = client.New(h).RawPost(`{ NoSuchField }`)
+	if err == nil || !strings.Contains(err.Error(), "NoSuchField") {
+		t.Errorf("validation: got %v", err)
+	}
+}
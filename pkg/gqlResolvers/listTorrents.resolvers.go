@@ -3,12 +3,11 @@ package gqlResolvers
 // This file will be automatically regenerated based on the schema, any resolver
 // implementations
 // will be copied through when generating and any unknown code will be moved to the end.
-// Code generated by github.com/99designs/gqlgen version v0.17.93
+// Code generated by github.com/99designs/gqlgen version v0.17.94
 
 import (
 	"context"
 	"errors"
-	"fmt"
 	"slices"
 	"strings"
 
@@ -18,58 +17,19 @@ import (
 )
 
 // Torrents is the resolver for the Torrents field.
-func (r *queryResolver) Torrents(ctx context.Context, categories []string, servers []string) ([]gqlGenerated.Torrent, error) {
-	var qbClients []*qbClient.Client
-
-	if len(servers) == 0 {
-		qbClients = qbClient.Registry().All()
-	} else {
-		for _, client := range servers {
-			curr, exist := qbClient.Registry().Get(client)
-			if !exist {
-				return nil, fmt.Errorf("client %s does not exist", client)
-			}
-			qbClients = append(qbClients, curr)
-		}
-	}
-
-	rtnMe := make([]gqlGenerated.Torrent, 0)
-
-	for _, client := range qbClients {
-		torrents, errL := client.GetTorrents(ctx)
-		if errL != nil {
-			return nil, errL
-		}
-
-		for _, torrent := range torrents {
-			match := slices.Contains(categories, torrent.Category)
-			if !match && len(categories) > 0 {
-				continue
-			}
+func (r *queryResolver) Torrents(ctx context.Context, categories []string, servers []string, filter *string, search *string, sortBy *gqlGenerated.TorrentSortField, order *gqlGenerated.SortOrder) ([]gqlGenerated.Torrent, error) {
+	return listTorrents(ctx, torrentsArgs{categories, servers, filter, search, sortBy, order})
+}
 
-			curr := gqlGenerated.Torrent{
-				Server:     torrent.Client.BasePath.String(),
-				Name:       torrent.Name,
-				Category:   torrent.Category,
-				Ratio:      torrent.Ratio,
-				InfoHashV1: torrent.InfohashV1,
-				Comment:    torrent.Comment,
-				RootPath:   torrent.RootPath,
-				SavePath:   torrent.SavePath,
-				SizeBytes:  torrent.Size,
-				TrackerURL: torrent.Tracker,
-				AddedOn:    torrent.AddedOn.Time().Unix(),
-				State:      torrent.State,
-			}
-			rtnMe = append(rtnMe, curr)
-		}
+// TorrentsConnection is the resolver for the TorrentsConnection field.
+func (r *queryResolver) TorrentsConnection(ctx context.Context, categories []string, servers []string, filter *string, search *string, sortBy *gqlGenerated.TorrentSortField, order *gqlGenerated.SortOrder, first *int, after *string, last *int, before *string) (*gqlGenerated.TorrentConnection, error) {
+	torrents, err := listTorrents(ctx, torrentsArgs{categories, servers, filter, search, sortBy, order})
+	if err != nil {
+		return nil, err
 	}
 
-	slices.SortFunc(rtnMe, func(a, b gqlGenerated.Torrent) int {
-		return strings.Compare(a.Server, b.Server)
-	})
-
-	return rtnMe, nil
+	sortField, sortOrder := sortArgs(sortBy, order)
+	return paginate(torrents, sortField, sortOrder, pageArgs{first, after, last, before})
 }
 
 // Categories is the resolver for the Categories field.
@@ -112,18 +72,8 @@ func (r *queryResolver) Torrent(ctx context.Context, infoHashV1 string) ([]*gqlG
 			}
 		}
 
-		rtnMe = append(rtnMe, &gqlGenerated.Torrent{
-			Server:     client.BasePath.String(),
-			Name:       torrent.Name,
-			Category:   torrent.Category,
-			Ratio:      torrent.Ratio,
-			InfoHashV1: torrent.InfohashV1,
-			Comment:    torrent.Comment,
-			RootPath:   torrent.RootPath,
-			SavePath:   torrent.SavePath,
-			SizeBytes:  torrent.Size,
-			TrackerURL: torrent.Tracker,
-		})
+		gqlTorrent := torrentToGql(torrent)
+		rtnMe = append(rtnMe, &gqlTorrent)
 	}
 
 	if len(rtnMe) == 0 {
@@ -134,13 +84,7 @@ func (r *queryResolver) Torrent(ctx context.Context, infoHashV1 string) ([]*gqlG
 
 // Trackers is the resolver for the Trackers field.
 func (r *torrentResolver) Trackers(ctx context.Context, obj *gqlGenerated.Torrent) ([]gqlGenerated.Tracker, error) {
-	client, exist := qbClient.Registry().Get(obj.Server)
-
-	if !exist {
-		return nil, fmt.Errorf("client not found")
-	}
-
-	trackers, err := client.GetTracker(ctx, obj.InfoHashV1)
+	trackers, err := loadersFrom(ctx).trackers.load(ctx, obj.Server, obj.InfoHashV1)
 	if err != nil {
 		return nil, err
 	}
@@ -165,13 +109,7 @@ func (r *torrentResolver) Trackers(ctx context.Context, obj *gqlGenerated.Torren
 
 // Files is the resolver for the Files field.
 func (r *torrentResolver) Files(ctx context.Context, obj *gqlGenerated.Torrent) ([]gqlGenerated.File, error) {
-	client, exist := qbClient.Registry().Get(obj.Server)
-
-	if !exist {
-		return nil, fmt.Errorf("client not found")
-	}
-
-	files, err := client.GetFilesInTorrent(ctx, obj.InfoHashV1)
+	files, err := loadersFrom(ctx).files.load(ctx, obj.Server, obj.InfoHashV1)
 	if err != nil {
 		return nil, err
 	}
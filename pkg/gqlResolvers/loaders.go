@@ -0,0 +1,155 @@
+package gqlResolvers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+// maxRequestsPerServer caps the requests the loaders of one operation send to a server at a time. gqlgen
+// resolves the Trackers and Files of every torrent in a list at once, without it a list of 500 torrents
+// would be 500 requests to the same seedbox in parallel.
+const maxRequestsPerServer = 4
+
+// Loaders is a gqlgen extension giving every operation its own loaders, so results are only cached for
+// the request that fetched them.
+type Loaders struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+} = Loaders{}
+
+func (Loaders) ExtensionName() string {
+	return "Loaders"
+}
+
+func (Loaders) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (Loaders) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	return next(context.WithValue(ctx, loadersKey{}, newLoaders()))
+}
+
+type loadersKey struct{}
+
+// loaders fetch the nested fields of torrents. qBittorrent has no endpoint returning the trackers, with their
+// status, or the files of several torrents, sync/maindata only has tracker urls, so each torrent is still a
+// request. The loaders make it one request per torrent per operation, however many times it's asked for.
+type loaders struct {
+	trackers *loader[[]*qbClient.TorrentTracker]
+	files    *loader[[]qbClient.TorrentFile]
+}
+
+func newLoaders() *loaders {
+	// The slots are shared so trackers and files together stay under maxRequestsPerServer.
+	slots := &serverSlots{slots: make(map[string]chan struct{})}
+
+	return &loaders{
+		trackers: newLoader(slots, (*qbClient.Client).GetTracker),
+		files:    newLoader(slots, (*qbClient.Client).GetFilesInTorrent),
+	}
+}
+
+// loadersFrom returns the operation's loaders, or new ones when the resolver wasn't called through a handler
+// with the Loaders extension.
+func loadersFrom(ctx context.Context) *loaders {
+	if rtnMe, ok := ctx.Value(loadersKey{}).(*loaders); ok {
+		return rtnMe
+	}
+	return newLoaders()
+}
+
+type serverSlots struct {
+	mu    sync.Mutex
+	slots map[string]chan struct{}
+}
+
+func (s *serverSlots) get(server string) chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rtnMe, exist := s.slots[server]
+	if !exist {
+		rtnMe = make(chan struct{}, maxRequestsPerServer)
+		s.slots[server] = rtnMe
+	}
+	return rtnMe
+}
+
+type torrentKey struct {
+	server string
+	hash   string
+}
+
+type loadResult[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// loader fetches a value once per torrent, callers asking for a torrent already being fetched wait for it.
+// Errors are cached too, retrying within one operation would only slow it down.
+type loader[T any] struct {
+	slots *serverSlots
+	fetch func(client *qbClient.Client, ctx context.Context, hash string) (T, error)
+
+	mu      sync.Mutex
+	results map[torrentKey]*loadResult[T]
+}
+
+func newLoader[T any](slots *serverSlots, fetch func(client *qbClient.Client, ctx context.Context, hash string) (T, error)) *loader[T] {
+	return &loader[T]{
+		slots:   slots,
+		fetch:   fetch,
+		results: make(map[torrentKey]*loadResult[T]),
+	}
+}
+
+func (l *loader[T]) load(ctx context.Context, server string, hash string) (T, error) {
+	key := torrentKey{server: server, hash: hash}
+
+	l.mu.Lock()
+	result, exist := l.results[key]
+	if !exist {
+		result = &loadResult[T]{done: make(chan struct{})}
+		l.results[key] = result
+	}
+	l.mu.Unlock()
+
+	if !exist {
+		result.value, result.err = l.fetchWithSlot(ctx, key)
+		close(result.done)
+	}
+
+	select {
+	case <-result.done:
+		return result.value, result.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+func (l *loader[T]) fetchWithSlot(ctx context.Context, key torrentKey) (T, error) {
+	var zero T
+
+	client, exist := qbClient.Registry().Get(key.server)
+	if !exist {
+		return zero, fmt.Errorf("client not found")
+	}
+
+	slots := l.slots.get(key.server)
+	select {
+	case slots <- struct{}{}:
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+	defer func() { <-slots }()
+
+	return l.fetch(client, ctx, key.hash)
+}
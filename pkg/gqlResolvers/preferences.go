@@ -0,0 +1,102 @@
+package gqlResolvers
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/gqlGenerated"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+func preferencesToGql(client *qbClient.Client, prefs *qbClient.Preferences) (*gqlGenerated.Preferences, error) {
+	prefsJson, err := json.Marshal(prefs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlGenerated.Preferences{
+		Server:                   client.Name,
+		SavePath:                 prefs.SavePath,
+		TempPathEnabled:          prefs.TempPathEnabled,
+		TempPath:                 prefs.TempPath,
+		AutoTmmEnabled:           prefs.AutoTmmEnabled,
+		ListenPort:               prefs.ListenPort,
+		RandomPort:               prefs.RandomPort,
+		Upnp:                     prefs.Upnp,
+		Encryption:               prefs.Encryption,
+		AnonymousMode:            prefs.AnonymousMode,
+		Dht:                      prefs.Dht,
+		Pex:                      prefs.Pex,
+		Lsd:                      prefs.Lsd,
+		QueueingEnabled:          prefs.QueueingEnabled,
+		MaxActiveDownloads:       prefs.MaxActiveDownloads,
+		MaxActiveTorrents:        prefs.MaxActiveTorrents,
+		MaxActiveUploads:         prefs.MaxActiveUploads,
+		MaxConnections:           prefs.MaxConnec,
+		MaxConnectionsPerTorrent: prefs.MaxConnecPerTorrent,
+		MaxUploads:               prefs.MaxUploads,
+		MaxUploadsPerTorrent:     prefs.MaxUploadsPerTorrent,
+		DownloadLimit:            prefs.DlLimit,
+		UploadLimit:              prefs.UpLimit,
+		AltDownloadLimit:         prefs.AltDlLimit,
+		AltUploadLimit:           prefs.AltUpLimit,
+		MaxRatioEnabled:          prefs.MaxRatioEnabled,
+		MaxRatio:                 prefs.MaxRatio,
+		MaxSeedingTimeEnabled:    prefs.MaxSeedingTimeEnabled,
+		MaxSeedingTime:           prefs.MaxSeedingTime,
+		JSON:                     string(prefsJson),
+	}, nil
+}
+
+// preferencesPatchToMap turns the set fields of patch into qBittorrent preference keys.
+// Typed fields win over the same key given in the raw Json object.
+func preferencesPatchToMap(patch *gqlGenerated.PreferencesPatch) (map[string]any, error) {
+	rtnMe := make(map[string]any)
+	if patch == nil {
+		return rtnMe, nil
+	}
+
+	if patch.JSON != nil && *patch.JSON != "" {
+		err := json.Unmarshal([]byte(*patch.JSON), &rtnMe)
+		if err != nil {
+			return nil, errors.New("Json must be a JSON object: " + err.Error())
+		}
+	}
+
+	setIfNotNil(rtnMe, "save_path", patch.SavePath)
+	setIfNotNil(rtnMe, "temp_path_enabled", patch.TempPathEnabled)
+	setIfNotNil(rtnMe, "temp_path", patch.TempPath)
+	setIfNotNil(rtnMe, "auto_tmm_enabled", patch.AutoTmmEnabled)
+	setIfNotNil(rtnMe, "listen_port", patch.ListenPort)
+	setIfNotNil(rtnMe, "random_port", patch.RandomPort)
+	setIfNotNil(rtnMe, "upnp", patch.Upnp)
+	setIfNotNil(rtnMe, "encryption", patch.Encryption)
+	setIfNotNil(rtnMe, "anonymous_mode", patch.AnonymousMode)
+	setIfNotNil(rtnMe, "dht", patch.Dht)
+	setIfNotNil(rtnMe, "pex", patch.Pex)
+	setIfNotNil(rtnMe, "lsd", patch.Lsd)
+	setIfNotNil(rtnMe, "queueing_enabled", patch.QueueingEnabled)
+	setIfNotNil(rtnMe, "max_active_downloads", patch.MaxActiveDownloads)
+	setIfNotNil(rtnMe, "max_active_torrents", patch.MaxActiveTorrents)
+	setIfNotNil(rtnMe, "max_active_uploads", patch.MaxActiveUploads)
+	setIfNotNil(rtnMe, "max_connec", patch.MaxConnections)
+	setIfNotNil(rtnMe, "max_connec_per_torrent", patch.MaxConnectionsPerTorrent)
+	setIfNotNil(rtnMe, "max_uploads", patch.MaxUploads)
+	setIfNotNil(rtnMe, "max_uploads_per_torrent", patch.MaxUploadsPerTorrent)
+	setIfNotNil(rtnMe, "dl_limit", patch.DownloadLimit)
+	setIfNotNil(rtnMe, "up_limit", patch.UploadLimit)
+	setIfNotNil(rtnMe, "alt_dl_limit", patch.AltDownloadLimit)
+	setIfNotNil(rtnMe, "alt_up_limit", patch.AltUploadLimit)
+	setIfNotNil(rtnMe, "max_ratio_enabled", patch.MaxRatioEnabled)
+	setIfNotNil(rtnMe, "max_ratio", patch.MaxRatio)
+	setIfNotNil(rtnMe, "max_seeding_time_enabled", patch.MaxSeedingTimeEnabled)
+	setIfNotNil(rtnMe, "max_seeding_time", patch.MaxSeedingTime)
+
+	return rtnMe, nil
+}
+
+func setIfNotNil[T any](m map[string]any, key string, value *T) {
+	if value != nil {
+		m[key] = *value
+	}
+}
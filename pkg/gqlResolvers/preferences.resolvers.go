@@ -0,0 +1,50 @@
+package gqlResolvers
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/gqlGenerated"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+// SetPreferences is the resolver for the setPreferences field.
+func (r *mutationResolver) SetPreferences(ctx context.Context, args gqlGenerated.SetPreferencesArgs) (*gqlGenerated.SetPreferencesResult, error) {
+	clients, err := qbClient.Registry().Resolve([]string{args.Server})
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := preferencesPatchToMap(args.Preferences)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, client := range clients {
+		errL := client.SetPreferences(ctx, patch)
+		if errL != nil {
+			return nil, errL
+		}
+	}
+
+	return &gqlGenerated.SetPreferencesResult{Success: true}, nil
+}
+
+// Preferences is the resolver for the Preferences field.
+func (r *queryResolver) Preferences(ctx context.Context, server string) (*gqlGenerated.Preferences, error) {
+	client, found := qbClient.Registry().Get(server)
+	if !found {
+		return nil, qbClient.ErrClientNotFound
+	}
+
+	prefs, err := client.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return preferencesToGql(client, prefs)
+}
@@ -0,0 +1,734 @@
+package gqlResolvers_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbFake"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/routers"
+)
+
+var (
+	alpha *qbFake.Server
+	beta  *qbFake.Server
+)
+
+// TestMain points the registry at two fake servers in the group lab. The registry is shared by every test,
+// so tests only change torrents they own.
+func TestMain(m *testing.M) {
+	alpha = qbFake.New(qbFake.WithTorrents(
+		qbFake.Torrent{Name: "alpha-debian.iso", Category: "linux", Size: 100},
+		qbFake.Torrent{Name: "alpha-pause-me", Category: "misc", Size: 50},
+	))
+	beta = qbFake.New(qbFake.WithTorrents(
+		qbFake.Torrent{Name: "beta-ubuntu.iso", Category: "linux", Size: 200},
+	))
+
+	alphaLogin, betaLogin := alpha.Login("alpha"), beta.Login("beta")
+	alphaLogin.Group, betaLogin.Group = "lab", "lab"
+
+	qbFake.Main(m, qbFake.TestConfig{Endpoints: []configuration.QbLogin{alphaLogin, betaLogin}}, alpha, beta)
+}
+
+func newClient() *client.Client {
+	return client.New(routers.NewGraphqlHandler())
+}
+
+type torrentsResponse struct {
+	Torrents []struct {
+		Server string
+		Name   string
+	}
+}
+
+func torrentNames(resp torrentsResponse) []string {
+	rtnMe := make([]string, 0, len(resp.Torrents))
+	for _, torrent := range resp.Torrents {
+		rtnMe = append(rtnMe, torrent.Server+"/"+torrent.Name)
+	}
+	slices.Sort(rtnMe)
+	return rtnMe
+}
+
+func TestTorrentsByServer(t *testing.T) {
+	c := newClient()
+
+	tests := []struct {
+		servers []string
+		want    []string
+	}{
+		{[]string{"beta"}, []string{"beta/beta-ubuntu.iso"}},
+		{[]string{"lab"}, []string{"alpha/alpha-debian.iso", "alpha/alpha-pause-me", "beta/beta-ubuntu.iso"}},
+		{[]string{beta.URL}, []string{"beta/beta-ubuntu.iso"}},
+	}
+
+	for _, test := range tests {
+		var resp torrentsResponse
+		err := c.Post(`query($servers: [String!]) { Torrents(servers: $servers) { Server Name } }`, &resp,
+			client.Var("servers", test.servers))
+		if err != nil {
+			t.Fatalf("servers %v: %v", test.servers, err)
+		}
+		if got := torrentNames(resp); !slices.Equal(got, test.want) {
+			t.Errorf("servers %v: got %v, want %v", test.servers, got, test.want)
+		}
+	}
+}
+
+func TestTorrentsByCategory(t *testing.T) {
+	var resp torrentsResponse
+	err := newClient().Post(`{ Torrents(categories: ["linux"]) { Server Name } }`, &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"alpha/alpha-debian.iso", "beta/beta-ubuntu.iso"}
+	if got := torrentNames(resp); !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestUnknownServer(t *testing.T) {
+	resp, err := newClient().RawPost(`{ Torrents(servers: ["nope"]) { Name } }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var errs []struct {
+		Extensions map[string]any
+	}
+	if err = json.Unmarshal(resp.Errors, &errs); err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 1 || errs[0].Extensions["code"] != "NOT_FOUND" {
+		t.Errorf("got errors %s, want NOT_FOUND", resp.Errors)
+	}
+}
+
+func TestServers(t *testing.T) {
+	var resp struct {
+		Servers []struct {
+			Server  string
+			Group   *string
+			Version string
+		}
+	}
+	err := newClient().Post(`{ Servers { Server Group Version } }`, &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.Servers) != 2 {
+		t.Fatalf("got %d servers, want 2", len(resp.Servers))
+	}
+	for _, server := range resp.Servers {
+		if server.Group == nil || *server.Group != "lab" || server.Version != qbFake.DefaultVersion {
+			t.Errorf("got %+v", server)
+		}
+	}
+}
+
+func TestPauseTorrents(t *testing.T) {
+	hash := alpha.HashOf("alpha-pause-me")
+
+	var resp struct {
+		PauseTorrents struct{ Success bool }
+	}
+	err := newClient().Post(`mutation($hash: String!) {
+		pauseTorrents(args: {Torrents: [{Server: "alpha", Hash: $hash}]}) { Success }
+	}`, &resp, client.Var("hash", hash))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if torrent, _ := alpha.Torrent(hash); torrent.State != "stoppedUP" {
+		t.Errorf("state = %s, want stoppedUP", torrent.State)
+	}
+}
+
+func TestCreateCategoryInGroup(t *testing.T) {
+	var resp struct {
+		CreateCategory struct{ Success bool }
+	}
+	err := newClient().Post(`mutation {
+		createCategory(args: {Name: "from-test", Path: "/data/from-test", Server: "lab"}) { Success }
+	}`, &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, server := range []*qbFake.Server{alpha, beta} {
+		if _, exist := server.Categories()["from-test"]; !exist {
+			t.Errorf("category missing on %s", server.URL)
+		}
+	}
+}
+
+func TestPreferences(t *testing.T) {
+	c := newClient()
+
+	var setResp struct {
+		SetPreferences struct{ Success bool }
+	}
+	err := c.Post(`mutation {
+		setPreferences(args: {Server: "beta", Preferences: {MaxActiveUploads: 7}}) { Success }
+	}`, &setResp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var resp struct {
+		Preferences struct {
+			Server           string
+			MaxActiveUploads int
+		}
+	}
+	err = c.Post(`{ Preferences(server: "beta") { Server MaxActiveUploads } }`, &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Preferences.MaxActiveUploads != 7 {
+		t.Errorf("MaxActiveUploads = %d, want 7", resp.Preferences.MaxActiveUploads)
+	}
+}
+
+func TestTorrentsFilter(t *testing.T) {
+	c := newClient()
+
+	var resp torrentsResponse
+	err := c.Post(`{ Torrents(filter: "category = linux and size > 150") { Server Name } }`, &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := torrentNames(resp); !slices.Equal(got, []string{"beta/beta-ubuntu.iso"}) {
+		t.Errorf("got %v", got)
+	}
+
+	raw, err := c.RawPost(`{ Torrents(filter: "size >") { Name } }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var errs []struct {
+		Extensions map[string]any
+	}
+	if err = json.Unmarshal(raw.Errors, &errs); err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 1 || errs[0].Extensions["code"] != "INVALID_FILTER" || errs[0].Extensions["column"] != float64(7) {
+		t.Errorf("got errors %s, want INVALID_FILTER at column 7", raw.Errors)
+	}
+}
+
+type connectionResponse struct {
+	TorrentsConnection struct {
+		Edges []struct {
+			Cursor string
+			Node   struct {
+				Server string
+				Name   string
+			}
+		}
+		PageInfo struct {
+			HasNextPage     bool
+			HasPreviousPage bool
+			EndCursor       *string
+		}
+		TotalCount int
+	}
+}
+
+func (resp connectionResponse) names() []string {
+	rtnMe := make([]string, 0)
+	for _, edge := range resp.TorrentsConnection.Edges {
+		rtnMe = append(rtnMe, edge.Node.Server+"/"+edge.Node.Name)
+	}
+	return rtnMe
+}
+
+const connectionQuery = `query($after: String, $before: String, $first: Int, $last: Int, $order: SortOrder) {
+	TorrentsConnection(search: "page", sortBy: NAME, order: $order, first: $first, after: $after, last: $last, before: $before) {
+		edges { cursor node { Server Name } }
+		pageInfo { hasNextPage hasPreviousPage endCursor }
+		totalCount
+	}
+}`
+
+// TestTorrentsConnection adds its own torrents and only searches for them, so it doesn't see the other tests'.
+func TestTorrentsConnection(t *testing.T) {
+	c := newClient()
+	alpha.AddTorrent(qbFake.Torrent{Name: "page-b"})
+	alpha.AddTorrent(qbFake.Torrent{Name: "Page-D"})
+	beta.AddTorrent(qbFake.Torrent{Name: "page-a"})
+	beta.AddTorrent(qbFake.Torrent{Name: "page-c"})
+	beta.AddTorrent(qbFake.Torrent{Name: "page-e"})
+
+	var first connectionResponse
+	err := c.Post(connectionQuery, &first, client.Var("first", 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := first.names(); !slices.Equal(got, []string{"beta/page-a", "alpha/page-b"}) {
+		t.Errorf("first page: got %v", got)
+	}
+	if info := first.TorrentsConnection.PageInfo; !info.HasNextPage || info.HasPreviousPage || first.TorrentsConnection.TotalCount != 5 {
+		t.Errorf("first page: got %+v, totalCount %d", info, first.TorrentsConnection.TotalCount)
+	}
+
+	// A torrent sorting before the cursor doesn't shift the next page.
+	alpha.AddTorrent(qbFake.Torrent{Name: "page-0"})
+
+	var second connectionResponse
+	err = c.Post(connectionQuery, &second, client.Var("first", 2), client.Var("after", *first.TorrentsConnection.PageInfo.EndCursor))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := second.names(); !slices.Equal(got, []string{"beta/page-c", "alpha/Page-D"}) {
+		t.Errorf("second page: got %v", got)
+	}
+	if info := second.TorrentsConnection.PageInfo; !info.HasNextPage || !info.HasPreviousPage {
+		t.Errorf("second page: got %+v", info)
+	}
+
+	var previous connectionResponse
+	err = c.Post(connectionQuery, &previous, client.Var("last", 2), client.Var("before", second.TorrentsConnection.Edges[0].Cursor))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := previous.names(); !slices.Equal(got, []string{"beta/page-a", "alpha/page-b"}) {
+		t.Errorf("before the second page: got %v", got)
+	}
+
+	var desc connectionResponse
+	err = c.Post(connectionQuery, &desc, client.Var("first", 2), client.Var("order", "DESC"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := desc.names(); !slices.Equal(got, []string{"beta/page-e", "alpha/Page-D"}) {
+		t.Errorf("descending: got %v", got)
+	}
+
+	// A cursor only works with the order it came from.
+	raw, err := c.RawPost(connectionQuery, client.Var("after", desc.TorrentsConnection.Edges[0].Cursor))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var errs []struct {
+		Extensions map[string]any
+	}
+	if err = json.Unmarshal(raw.Errors, &errs); err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 1 || errs[0].Extensions["code"] != "BAD_USER_INPUT" {
+		t.Errorf("got errors %s, want BAD_USER_INPUT", raw.Errors)
+	}
+}
+
+const sortedConnectionQuery = `query($sortBy: TorrentSortField, $order: SortOrder, $after: String) {
+	TorrentsConnection(search: "tied", sortBy: $sortBy, order: $order, first: 2, after: $after) {
+		edges { cursor node { Server Name } }
+		pageInfo { hasNextPage hasPreviousPage endCursor }
+		totalCount
+	}
+}`
+
+// TestTorrentsConnectionTies pages through torrents whose sort values tie, every torrent comes exactly once.
+func TestTorrentsConnectionTies(t *testing.T) {
+	c := newClient()
+	alpha.AddTorrent(qbFake.Torrent{Name: "tied-b", NumSeeds: 5, CompletionOn: 1700000000})
+	alpha.AddTorrent(qbFake.Torrent{Name: "tied-few", NumSeeds: 1})
+	beta.AddTorrent(qbFake.Torrent{Name: "tied-a", NumSeeds: 5, CompletionOn: 1700000000})
+	beta.AddTorrent(qbFake.Torrent{Name: "tied-c", NumSeeds: 5})
+	beta.AddTorrent(qbFake.Torrent{Name: "tied-many", NumSeeds: 9, CompletionOn: 1600000000})
+
+	// pages returns every torrent, a page of two at a time.
+	pages := func(sortBy, order string) []string {
+		t.Helper()
+
+		rtnMe := make([]string, 0)
+		var after *string
+		for range 5 {
+			var resp connectionResponse
+			err := c.Post(sortedConnectionQuery, &resp,
+				client.Var("sortBy", sortBy), client.Var("order", order), client.Var("after", after))
+			if err != nil {
+				t.Fatal(err)
+			}
+			rtnMe = append(rtnMe, resp.names()...)
+			if !resp.TorrentsConnection.PageInfo.HasNextPage {
+				return rtnMe
+			}
+			after = resp.TorrentsConnection.PageInfo.EndCursor
+		}
+		t.Fatalf("%s %s: more pages than torrents, got %v", sortBy, order, rtnMe)
+		return nil
+	}
+
+	tests := []struct {
+		sortBy string
+		order  string
+		want   []string
+	}{
+		{"SEEDS", "ASC", []string{"alpha/tied-few", "beta/tied-a", "alpha/tied-b", "beta/tied-c", "beta/tied-many"}},
+		{"SEEDS", "DESC", []string{"beta/tied-many", "beta/tied-c", "alpha/tied-b", "beta/tied-a", "alpha/tied-few"}},
+		// Torrents that haven't completed are last in both orders.
+		{"COMPLETED_AT", "ASC", []string{"beta/tied-many", "beta/tied-a", "alpha/tied-b", "beta/tied-c", "alpha/tied-few"}},
+		{"COMPLETED_AT", "DESC", []string{"alpha/tied-b", "beta/tied-a", "beta/tied-many", "alpha/tied-few", "beta/tied-c"}},
+	}
+
+	for _, test := range tests {
+		if got := pages(test.sortBy, test.order); !slices.Equal(got, test.want) {
+			t.Errorf("%s %s: got %v\nwant %v", test.sortBy, test.order, got, test.want)
+		}
+	}
+}
+
+func TestStats(t *testing.T) {
+	c := newClient()
+
+	var resp struct {
+		Stats struct {
+			Total struct {
+				Count     int
+				SizeBytes int64
+			}
+			Groups []struct {
+				Key   string
+				Stats struct {
+					Count     int
+					SizeBytes int64
+				}
+			}
+			Histogram []struct {
+				Label string
+				Count int
+			}
+		}
+	}
+	err := c.Post(`{ Stats(categories: ["linux"], groupBy: SERVER, histogram: RATIO, buckets: [1]) {
+		Total { Count SizeBytes }
+		Groups { Key Stats { Count SizeBytes } }
+		Histogram { Label Count }
+	} }`, &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stats := resp.Stats
+	if stats.Total.Count != 2 || stats.Total.SizeBytes != 300 {
+		t.Errorf("total: got %+v", stats.Total)
+	}
+	if len(stats.Groups) != 2 || stats.Groups[0].Key != "alpha" || stats.Groups[0].Stats.SizeBytes != 100 ||
+		stats.Groups[1].Key != "beta" || stats.Groups[1].Stats.SizeBytes != 200 {
+		t.Errorf("groups: got %+v", stats.Groups)
+	}
+	if len(stats.Histogram) != 2 || stats.Histogram[0].Label != "< 1" || stats.Histogram[0].Count != 2 {
+		t.Errorf("histogram: got %+v", stats.Histogram)
+	}
+}
+
+func TestTorrentFields(t *testing.T) {
+	c := newClient()
+	alpha.AddTorrent(qbFake.Torrent{
+		Name:     "fields",
+		Tags:     "keep, linux",
+		State:    "someFutureState",
+		AddedOn:  1754000000,
+		Progress: 0.5,
+		Dlspeed:  2048,
+	})
+
+	var resp struct {
+		Torrent []struct {
+			Server        string
+			Tags          []string
+			State         string
+			Progress      float64
+			DownloadSpeed int64
+			AddedAt       string
+			AddedOn       int64
+			CompletedAt   *string
+			InfoHashV2    *string
+		}
+	}
+	err := c.Post(`query($hash: String!) { Torrent(infoHashV1: $hash) {
+		Server Tags State Progress DownloadSpeed AddedAt AddedOn CompletedAt InfoHashV2
+	} }`, &resp, client.Var("hash", alpha.HashOf("fields")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.Torrent) != 1 {
+		t.Fatalf("got %+v", resp.Torrent)
+	}
+	got := resp.Torrent[0]
+	if got.Server != "alpha" || !slices.Equal(got.Tags, []string{"keep", "linux"}) || got.State != "unknown" ||
+		got.Progress != 0.5 || got.DownloadSpeed != 2048 {
+		t.Errorf("got %+v", got)
+	}
+	if got.AddedAt != "2025-07-31T22:13:20Z" || got.AddedOn != 1754000000 {
+		t.Errorf("got AddedAt %s, AddedOn %d", got.AddedAt, got.AddedOn)
+	}
+	if got.CompletedAt != nil || got.InfoHashV2 != nil {
+		t.Errorf("got CompletedAt %v, InfoHashV2 %v, want null", got.CompletedAt, got.InfoHashV2)
+	}
+}
+
+func TestTrackersLoadedOnce(t *testing.T) {
+	c := newClient()
+	beta.AddTorrent(qbFake.Torrent{
+		Name:     "loaded-once",
+		Trackers: []qbClient.TorrentTracker{{Url: "udp://tracker.example:6969"}},
+	})
+	before := beta.Requests("/api/v2/torrents/trackers")
+
+	var resp struct {
+		A []struct{ Trackers []struct{ Url string } }
+		B []struct{ Trackers []struct{ Url string } }
+	}
+	err := c.Post(`query($hash: String!) {
+		A: Torrent(infoHashV1: $hash) { Trackers { Url } }
+		B: Torrent(infoHashV1: $hash) { Trackers { Url } }
+	}`, &resp, client.Var("hash", beta.HashOf("loaded-once")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.A) != 1 || len(resp.B) != 1 || len(resp.A[0].Trackers) != 1 || len(resp.B[0].Trackers) != 1 {
+		t.Errorf("got %+v", resp)
+	}
+	if requests := beta.Requests("/api/v2/torrents/trackers") - before; requests != 1 {
+		t.Errorf("sent %d trackers requests, want 1", requests)
+	}
+}
+
+type mutationResponse struct {
+	ResumeTorrents struct {
+		Success bool
+		Results []struct {
+			Server    string
+			Hash      string
+			Ok        bool
+			ErrorCode *string
+		}
+	}
+}
+
+// outcomes returns server/hash=code for every result, ok for the ones that succeeded.
+func (resp mutationResponse) outcomes() []string {
+	rtnMe := make([]string, 0)
+	for _, result := range resp.ResumeTorrents.Results {
+		outcome := "ok"
+		if result.ErrorCode != nil {
+			outcome = *result.ErrorCode
+		}
+		rtnMe = append(rtnMe, result.Server+"/"+result.Hash+"="+outcome)
+	}
+	return rtnMe
+}
+
+const resumeMutation = `mutation($torrents: [ResumeTorrentInfo]!, $atomic: Boolean!) {
+	resumeTorrents(args: {Torrents: $torrents, Atomic: $atomic}) { Success Results { Server Hash Ok ErrorCode } }
+}`
+
+func TestMutationResults(t *testing.T) {
+	c := newClient()
+	alpha.AddTorrent(qbFake.Torrent{Name: "mutate-alpha", State: "stoppedUP"})
+	beta.AddTorrent(qbFake.Torrent{Name: "mutate-beta", State: "stoppedUP"})
+	alphaHash, betaHash := alpha.HashOf("mutate-alpha"), beta.HashOf("mutate-beta")
+
+	torrents := []map[string]string{
+		{"Server": "alpha", "Hash": alphaHash},
+		{"Server": "alpha", "Hash": "missing"},
+		{"Server": "nowhere", "Hash": alphaHash},
+		{"Server": "lab", "Hash": betaHash},
+	}
+
+	var atomic mutationResponse
+	err := c.Post(resumeMutation, &atomic, client.Var("torrents", torrents), client.Var("atomic", true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"alpha/" + alphaHash + "=NOT_APPLIED", "alpha/missing=NOT_FOUND", "nowhere/" + alphaHash + "=NOT_FOUND", "beta/" + betaHash + "=NOT_APPLIED"}
+	if got := atomic.outcomes(); atomic.ResumeTorrents.Success || !slices.Equal(got, want) {
+		t.Errorf("atomic: got success %v %v, want %v", atomic.ResumeTorrents.Success, got, want)
+	}
+	if torrent, _ := alpha.Torrent(alphaHash); torrent.State != "stoppedUP" {
+		t.Errorf("atomic: state = %s, want stoppedUP", torrent.State)
+	}
+
+	var partial mutationResponse
+	err = c.Post(resumeMutation, &partial, client.Var("torrents", torrents), client.Var("atomic", false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []string{"alpha/" + alphaHash + "=ok", "alpha/missing=NOT_FOUND", "nowhere/" + alphaHash + "=NOT_FOUND", "beta/" + betaHash + "=ok"}
+	if got := partial.outcomes(); partial.ResumeTorrents.Success || !slices.Equal(got, want) {
+		t.Errorf("partial: got success %v %v, want %v", partial.ResumeTorrents.Success, got, want)
+	}
+	for _, server := range []*qbFake.Server{alpha, beta} {
+		for _, hash := range []string{alphaHash, betaHash} {
+			if torrent, exist := server.Torrent(hash); exist && torrent.State != "stalledUP" {
+				t.Errorf("partial: state = %s, want stalledUP", torrent.State)
+			}
+		}
+	}
+}
+
+func TestFilterMutations(t *testing.T) {
+	c := newClient()
+	alpha.AddTorrent(qbFake.Torrent{Name: "filtered-one", Category: "filtered", State: "stalledUP", Tags: "old"})
+	beta.AddTorrent(qbFake.Torrent{Name: "filtered-two", Category: "filtered", State: "stalledUP", SeedingTimeLimit: 60, InactiveSeedingTimeLimit: -1})
+	beta.AddTorrent(qbFake.Torrent{Name: "filtered-done", Category: "filtered", State: "stoppedUP"})
+	oneHash, twoHash := alpha.HashOf("filtered-one"), beta.HashOf("filtered-two")
+
+	var resp struct {
+		PauseTorrents struct {
+			Success bool
+			Results []struct{ Server, Hash string }
+		}
+	}
+	const pause = `mutation($dryRun: Boolean!) {
+		pauseTorrents(args: {Filter: {Categories: ["filtered"], States: [stalledUP]}, DryRun: $dryRun}) { Success Results { Server Hash } }
+	}`
+
+	err := c.Post(pause, &resp, client.Var("dryRun", true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.PauseTorrents.Success || len(resp.PauseTorrents.Results) != 2 {
+		t.Errorf("dry run: got %+v, want the two stalledUP torrents", resp.PauseTorrents)
+	}
+	if torrent, _ := alpha.Torrent(oneHash); torrent.State != "stalledUP" {
+		t.Errorf("dry run: state = %s, want stalledUP", torrent.State)
+	}
+
+	err = c.Post(pause, &resp, client.Var("dryRun", false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.PauseTorrents.Success || len(resp.PauseTorrents.Results) != 2 {
+		t.Errorf("got %+v, want the two stalledUP torrents", resp.PauseTorrents)
+	}
+	if torrent, _ := alpha.Torrent(oneHash); torrent.State != "stoppedUP" {
+		t.Errorf("state = %s, want stoppedUP", torrent.State)
+	}
+	if torrent, _ := beta.Torrent(twoHash); torrent.State != "stoppedUP" {
+		t.Errorf("state = %s, want stoppedUP", torrent.State)
+	}
+
+	err = c.Post(`mutation {
+		tagTorrents(args: {Filter: {Expression: "category = filtered", Tags: ["old"]}, Add: ["new"], Remove: ["old"]}) { Success }
+	}`, &struct{ TagTorrents struct{ Success bool } }{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if torrent, _ := alpha.Torrent(oneHash); torrent.Tags != "new" {
+		t.Errorf("tags = %q, want new", torrent.Tags)
+	}
+	if torrent, _ := beta.Torrent(twoHash); torrent.Tags != "" {
+		t.Errorf("untagged torrent: tags = %q, want none", torrent.Tags)
+	}
+
+	err = c.Post(`mutation($hash: String!) {
+		setTorrentLimits(args: {Torrents: [{Server: "beta", Hash: $hash}], UploadLimit: 1024, RatioLimit: 2.5}) { Success }
+	}`, &struct{ SetTorrentLimits struct{ Success bool } }{}, client.Var("hash", twoHash))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The share limits that weren't given keep their values.
+	if torrent, _ := beta.Torrent(twoHash); torrent.UpLimit != 1024 || torrent.RatioLimit != 2.5 ||
+		torrent.SeedingTimeLimit != 60 || torrent.InactiveSeedingTimeLimit != -1 {
+		t.Errorf("limits = up %d ratio %v seeding %d inactive %d, want 1024 2.5 60 -1",
+			torrent.UpLimit, torrent.RatioLimit, torrent.SeedingTimeLimit, torrent.InactiveSeedingTimeLimit)
+	}
+
+	var move struct {
+		MoveTorrents struct {
+			Success bool
+			Results []struct{ Server, Hash string }
+		}
+	}
+	err = c.Post(`mutation {
+		moveTorrents(args: {Filter: {Categories: ["filtered"], States: [stoppedUP], Servers: ["beta"]}, Location: "/data/archive"}) {
+			Success Results { Server Hash }
+		}
+	}`, &move)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !move.MoveTorrents.Success || len(move.MoveTorrents.Results) != 2 {
+		t.Errorf("moveTorrents: got %+v, want the two stoppedUP torrents on beta", move.MoveTorrents)
+	}
+	if torrent, _ := beta.Torrent(twoHash); torrent.SavePath != "/data/archive" {
+		t.Errorf("save path = %q, want /data/archive", torrent.SavePath)
+	}
+	if torrent, _ := alpha.Torrent(oneHash); torrent.SavePath == "/data/archive" {
+		t.Error("moveTorrents moved a torrent on a server the filter didn't select")
+	}
+
+	raw, err := c.RawPost(`mutation { moveTorrents(args: {Filter: {Servers: ["beta"]}, Location: " "}) { Success } }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if codes := errorCodes(t, raw.Errors); len(codes) != 1 || codes[0] != "BAD_USER_INPUT" {
+		t.Errorf("empty Location: got errors %s, want BAD_USER_INPUT", raw.Errors)
+	}
+
+	raw, err = c.RawPost(fmt.Sprintf(`mutation {
+		recheckTorrents(args: {Torrents: [{Server: "alpha", Hash: %q}], Filter: {Servers: ["alpha"]}}) { Success }
+	}`, oneHash))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if codes := errorCodes(t, raw.Errors); len(codes) != 1 || codes[0] != "BAD_USER_INPUT" {
+		t.Errorf("Torrents and Filter: got errors %s, want BAD_USER_INPUT", raw.Errors)
+	}
+}
+
+func TestEmptyFilterRejected(t *testing.T) {
+	c := newClient()
+	alpha.AddTorrent(qbFake.Torrent{Name: "empty-filter", State: "stalledUP"})
+	hash := alpha.HashOf("empty-filter")
+
+	for _, filter := range []string{`{}`, `{Expression: " ", Search: ""}`} {
+		raw, err := c.RawPost(`mutation { deleteTorrents(args: {Filter: ` + filter + `, DeleteFiles: true}) { Success } }`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if codes := errorCodes(t, raw.Errors); len(codes) != 1 || codes[0] != "BAD_USER_INPUT" {
+			t.Errorf("Filter %s: got errors %s, want BAD_USER_INPUT", filter, raw.Errors)
+		}
+	}
+
+	if _, exist := alpha.Torrent(hash); !exist {
+		t.Error("empty filter deleted a torrent")
+	}
+}
+
+func TestServerAdminOffByDefault(t *testing.T) {
+	c := newClient()
+
+	mutations := []string{
+		fmt.Sprintf(`mutation { addServer(args: {Path: %q, ApiKey: "key"}) { Success } }`, alpha.URL+"/other"),
+		`mutation { removeServer(args: {Server: "beta"}) { Success } }`,
+		`mutation { updateServerCredentials(args: {Server: "beta", ApiKey: "key"}) { Success } }`,
+	}
+	for _, mutation := range mutations {
+		raw, err := c.RawPost(mutation)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if codes := errorCodes(t, raw.Errors); len(codes) != 1 || codes[0] != "FORBIDDEN" {
+			t.Errorf("%s: got errors %s, want FORBIDDEN", mutation, raw.Errors)
+		}
+	}
+
+	if got := len(qbClient.Registry().All()); got != 2 {
+		t.Errorf("got %d servers, want the 2 from the config", got)
+	}
+}
@@ -0,0 +1,52 @@
+package gqlResolvers
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/gqlGenerated"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+var errForbidden = errors.New("forbidden")
+
+// checkServerAdmin fails unless the config allows changing the servers at runtime.
+func checkServerAdmin() error {
+	if !configuration.MustGetConfig().ServerAdmin {
+		return fmt.Errorf("%w: changing servers is turned off, set serverAdmin in the config to allow it", errForbidden)
+	}
+	return nil
+}
+
+func serverToGql(client *qbClient.Client) *gqlGenerated.Server {
+	capabilities := make([]string, 0)
+	for _, capability := range client.Capabilities() {
+		capabilities = append(capabilities, string(capability))
+	}
+
+	labels := make([]gqlGenerated.ServerLabel, 0, len(client.Labels))
+	for k, v := range client.Labels {
+		labels = append(labels, gqlGenerated.ServerLabel{Key: k, Value: v})
+	}
+	slices.SortFunc(labels, func(a, b gqlGenerated.ServerLabel) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	var group *string
+	if client.Group != "" {
+		group = &client.Group
+	}
+
+	return &gqlGenerated.Server{
+		Server:       client.Name,
+		URL:          client.BasePath.String(),
+		Group:        group,
+		Labels:       labels,
+		Version:      client.Version(),
+		Capabilities: capabilities,
+		Available:    client.Available(),
+	}
+}
@@ -0,0 +1,137 @@
+package gqlResolvers
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/gqlGenerated"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+// AddServer is the resolver for the addServer field.
+func (r *mutationResolver) AddServer(ctx context.Context, args gqlGenerated.AddServerArgs) (*gqlGenerated.ServerMutationResult, error) {
+	if err := checkServerAdmin(); err != nil {
+		return nil, err
+	}
+
+	login := configuration.QbLogin{
+		Path:   args.Path,
+		ApiKey: configuration.Secret(args.APIKey),
+	}
+	if args.Name != nil {
+		login.Name = *args.Name
+	}
+	if args.Group != nil {
+		login.Group = *args.Group
+	}
+
+	client, err := qbClient.Login(ctx, login)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ref := range []string{client.Name, client.BasePath.String()} {
+		if _, exist := qbClient.Registry().Get(ref); exist {
+			return nil, qbClient.ErrClientExists
+		}
+	}
+
+	err = client.Validate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = qbClient.Registry().Add(client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlGenerated.ServerMutationResult{Success: true, Server: serverToGql(client)}, nil
+}
+
+// RemoveServer is the resolver for the removeServer field.
+func (r *mutationResolver) RemoveServer(ctx context.Context, args gqlGenerated.RemoveServerArgs) (*gqlGenerated.ServerMutationResult, error) {
+	if err := checkServerAdmin(); err != nil {
+		return nil, err
+	}
+
+	client, exist := qbClient.Registry().Get(args.Server)
+	if !exist {
+		return nil, qbClient.ErrClientNotFound
+	}
+
+	err := qbClient.Registry().Remove(client.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlGenerated.ServerMutationResult{Success: true}, nil
+}
+
+// UpdateServerCredentials is the resolver for the updateServerCredentials field.
+func (r *mutationResolver) UpdateServerCredentials(ctx context.Context, args gqlGenerated.UpdateServerCredentialsArgs) (*gqlGenerated.ServerMutationResult, error) {
+	if err := checkServerAdmin(); err != nil {
+		return nil, err
+	}
+
+	current, exist := qbClient.Registry().Get(args.Server)
+	if !exist {
+		return nil, qbClient.ErrClientNotFound
+	}
+
+	login := current.Config()
+	login.ApiKey = configuration.Secret(args.APIKey)
+	login.ApiKeyFile = ""
+	login.Username = ""
+	login.Password = ""
+	login.PasswordFile = ""
+
+	client, err := qbClient.Login(ctx, login)
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.Validate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = qbClient.Registry().Replace(client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlGenerated.ServerMutationResult{Success: true, Server: serverToGql(client)}, nil
+}
+
+// Servers is the resolver for the Servers field.
+func (r *queryResolver) Servers(ctx context.Context) ([]gqlGenerated.Server, error) {
+	clients := qbClient.Registry().All()
+
+	rtnMe := make([]gqlGenerated.Server, 0, len(clients))
+
+	for _, client := range clients {
+		if client.Version() == "" && client.Available() {
+			err := client.DetectVersion(ctx)
+			if err != nil {
+				slog.Warn("Failed to detect WebAPI version", "server", client.Name, "error", err)
+			}
+		}
+
+		rtnMe = append(rtnMe, *serverToGql(client))
+	}
+
+	slices.SortFunc(rtnMe, func(a, b gqlGenerated.Server) int {
+		return strings.Compare(a.Server, b.Server)
+	})
+
+	return rtnMe, nil
+}
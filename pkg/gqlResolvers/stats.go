@@ -0,0 +1,26 @@
+package gqlResolvers
+
+import (
+	"strings"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/gqlGenerated"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/helpers"
+)
+
+func statsToGql(stats helpers.TorrentStats) *gqlGenerated.TorrentStats {
+	return &gqlGenerated.TorrentStats{
+		Count:           stats.Count,
+		SizeBytes:       stats.Size,
+		UploadedBytes:   stats.Uploaded,
+		DownloadedBytes: stats.Downloaded,
+		AverageRatio:    stats.AverageRatio,
+	}
+}
+
+// The GraphQL enums are the helpers constants in upper case.
+func enumToHelpers[T ~string](value *T) string {
+	if value == nil {
+		return ""
+	}
+	return strings.ToLower(string(*value))
+}
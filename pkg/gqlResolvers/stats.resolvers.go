@@ -0,0 +1,54 @@
+package gqlResolvers
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/gqlGenerated"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/helpers"
+)
+
+// Stats is the resolver for the Stats field.
+func (r *queryResolver) Stats(ctx context.Context, categories []string, servers []string, filter *string, search *string, groupBy *gqlGenerated.StatsGroupBy, histogram *gqlGenerated.StatsHistogram, buckets []float64) (*gqlGenerated.Stats, error) {
+	torrents, err := matchingTorrents(ctx, torrentsArgs{categories: categories, servers: servers, filter: filter, search: search})
+	if err != nil {
+		return nil, err
+	}
+
+	total, groups, err := helpers.GroupStats(torrents, enumToHelpers(groupBy))
+	if err != nil {
+		return nil, err
+	}
+
+	rtnMe := &gqlGenerated.Stats{
+		Total:     statsToGql(total),
+		Groups:    make([]gqlGenerated.StatsGroup, len(groups)),
+		Histogram: make([]gqlGenerated.HistogramBucket, 0),
+	}
+	for i, group := range groups {
+		rtnMe.Groups[i] = gqlGenerated.StatsGroup{Key: group.Key, Stats: statsToGql(group.TorrentStats)}
+	}
+
+	if histogram != nil {
+		histogramBuckets, errL := helpers.Histogram(torrents, enumToHelpers(histogram), buckets, time.Now())
+		if errL != nil {
+			return nil, errL
+		}
+		for _, bucket := range histogramBuckets {
+			rtnMe.Histogram = append(rtnMe.Histogram, gqlGenerated.HistogramBucket{
+				Label:     bucket.Label,
+				Min:       bucket.Min,
+				Max:       bucket.Max,
+				Count:     bucket.Count,
+				SizeBytes: bucket.Size,
+			})
+		}
+	}
+
+	return rtnMe, nil
+}
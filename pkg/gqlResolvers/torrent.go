@@ -0,0 +1,111 @@
+package gqlResolvers
+
+import (
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/gqlGenerated"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+// etaInfinity is the eta qBittorrent reports for a torrent that won't finish, 100 days.
+const etaInfinity = 8640000
+
+// torrentToGql is how every resolver turns a qBittorrent torrent into a GraphQL one, torrent.Client must be set.
+func torrentToGql(torrent *qbClient.TorrentInfo) gqlGenerated.Torrent {
+	state := gqlGenerated.TorrentState(torrent.State)
+	if !state.IsValid() {
+		state = gqlGenerated.TorrentStateUnknown
+	}
+
+	rtnMe := gqlGenerated.Torrent{
+		Server:      torrent.Client.Name,
+		Name:        torrent.Name,
+		Category:    torrent.Category,
+		Tags:        torrent.TagList(),
+		State:       state,
+		InfoHashV1:  torrent.InfohashV1,
+		MagnetURI:   torrent.MagnetUri,
+		Comment:     torrent.Comment,
+		Private:     torrent.Private,
+		HasMetadata: torrent.HasMetadata,
+
+		Progress:      torrent.Progress,
+		Ratio:         torrent.Ratio,
+		Availability:  torrent.Availability,
+		Popularity:    torrent.Popularity,
+		DownloadSpeed: int64(torrent.Dlspeed),
+		UploadSpeed:   int64(torrent.Upspeed),
+
+		SizeBytes:              torrent.Size,
+		TotalSizeBytes:         torrent.TotalSize,
+		CompletedBytes:         torrent.Completed,
+		AmountLeftBytes:        int64(torrent.AmountLeft),
+		UploadedBytes:          torrent.Uploaded,
+		DownloadedBytes:        torrent.Downloaded,
+		UploadedSessionBytes:   int64(torrent.UploadedSession),
+		DownloadedSessionBytes: int64(torrent.DownloadedSession),
+
+		Seeds:        torrent.NumSeeds,
+		SeedsTotal:   torrent.NumComplete,
+		Leeches:      torrent.NumLeechs,
+		LeechesTotal: torrent.NumIncomplete,
+
+		AddedAt:            torrent.AddedOn.Time().UTC(),
+		CompletedAt:        unixToGql(torrent.CompletionOn.Time().Unix()),
+		LastActivityAt:     unixToGql(int64(torrent.LastActivity)),
+		SeenCompleteAt:     unixToGql(int64(torrent.SeenComplete)),
+		TimeActiveSeconds:  int64(torrent.TimeActive),
+		SeedingTimeSeconds: int64(torrent.SeedingTime),
+
+		RatioLimit:                      torrent.RatioLimit,
+		SeedingTimeLimitMinutes:         torrent.SeedingTimeLimit,
+		InactiveSeedingTimeLimitMinutes: torrent.InactiveSeedingTimeLimit,
+		MaxRatio:                        torrent.MaxRatio,
+		MaxSeedingTimeMinutes:           torrent.MaxSeedingTime,
+		MaxInactiveSeedingTimeMinutes:   torrent.MaxInactiveSeedingTime,
+
+		AutoTmm:                torrent.AutoTmm,
+		ForceStart:             torrent.ForceStart,
+		SequentialDownload:     torrent.SeqDl,
+		FirstLastPiecePriority: torrent.FLPiecePrio,
+		SuperSeeding:           torrent.SuperSeeding,
+
+		RootPath:          torrent.RootPath,
+		SavePath:          torrent.SavePath,
+		ContentPath:       torrent.ContentPath,
+		DownloadPath:      torrent.DownloadPath,
+		TrackerURL:        torrent.Tracker,
+		TrackersCount:     torrent.TrackersCount,
+		ReannounceSeconds: torrent.Reannounce,
+		AddedOn:           torrent.AddedOn.Time().Unix(),
+		QueuePosition:     torrent.Priority,
+	}
+
+	if torrent.InfohashV2 != "" {
+		rtnMe.InfoHashV2 = &torrent.InfohashV2
+	}
+	if torrent.Eta >= 0 && torrent.Eta < etaInfinity {
+		eta := int64(torrent.Eta)
+		rtnMe.EtaSeconds = &eta
+	}
+	// qBittorrent reports no limit as 0 or -1.
+	if torrent.DlLimit > 0 {
+		limit := int64(torrent.DlLimit)
+		rtnMe.DownloadLimit = &limit
+	}
+	if torrent.UpLimit > 0 {
+		limit := int64(torrent.UpLimit)
+		rtnMe.UploadLimit = &limit
+	}
+
+	return rtnMe
+}
+
+// unixToGql returns nil for the 0 or -1 qBittorrent uses for never.
+func unixToGql(unix int64) *time.Time {
+	if unix <= 0 {
+		return nil
+	}
+	rtnMe := time.Unix(unix, 0).UTC()
+	return &rtnMe
+}
@@ -0,0 +1,326 @@
+package gqlResolvers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/gqlGenerated"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/helpers"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+// Error codes only found in mutation results.
+const (
+	ErrCodeNotApplied = "NOT_APPLIED"
+	ErrCodeInternal   = "INTERNAL_ERROR"
+)
+
+var errNotApplied = errors.New("not applied, another torrent failed the atomic check")
+
+// torrentTarget is a torrent a bulk mutation was asked to change, server is a server name or group.
+type torrentTarget struct {
+	server string
+	hash   string
+}
+
+// torrentOutcome is the result for a torrent on one server, err is nil until something fails.
+type torrentOutcome struct {
+	server string
+	hash   string
+	client *qbClient.Client
+	err    error
+}
+
+// bulkArgs are the arguments every bulk mutation has. torrents is nil when the mutation wasn't given a list,
+// to tell it apart from an empty one.
+type bulkArgs struct {
+	torrents []torrentTarget
+	filter   *gqlGenerated.TorrentFilter
+	atomic   bool
+	dryRun   bool
+}
+
+// targetsOf turns the Torrents argument of a bulk mutation into targets, keeping nil as nil.
+func targetsOf[T any](torrents []*T, target func(torrent *T) torrentTarget) []torrentTarget {
+	if torrents == nil {
+		return nil
+	}
+
+	rtnMe := make([]torrentTarget, 0, len(torrents))
+	for _, torrent := range torrents {
+		if torrent != nil {
+			rtnMe = append(rtnMe, target(torrent))
+		}
+	}
+	return rtnMe
+}
+
+// applyToTorrents checks which servers have each torrent, then calls apply once per server with the hashes it
+// has. A server failing doesn't stop the others. With atomic nothing is applied if any check fails, with
+// dryRun nothing is applied at all. The error is for arguments that select no torrents to check.
+func applyToTorrents(ctx context.Context, args bulkArgs, apply func(ctx context.Context, client *qbClient.Client, hashes []string) error) (bool, []gqlGenerated.TorrentMutationResult, error) {
+	var outcomes []torrentOutcome
+	switch {
+	case (args.torrents == nil) == (args.filter == nil):
+		return false, nil, fmt.Errorf("%w: give either Torrents or Filter", errBadUserInput)
+	case args.filter != nil && emptyFilter(*args.filter):
+		return false, nil, fmt.Errorf("%w: Filter has no conditions, it would select every torrent", errBadUserInput)
+	case args.filter != nil:
+		var err error
+		outcomes, err = filterTorrents(ctx, *args.filter)
+		if err != nil {
+			return false, nil, err
+		}
+	default:
+		outcomes = checkTorrents(ctx, args.torrents)
+	}
+
+	failed := slices.ContainsFunc(outcomes, func(outcome torrentOutcome) bool { return outcome.err != nil })
+
+	if args.dryRun {
+		return !failed, mutationResults(ctx, outcomes), nil
+	}
+	if args.atomic && failed {
+		for i := range outcomes {
+			if outcomes[i].err == nil {
+				outcomes[i].err = errNotApplied
+			}
+		}
+		return false, mutationResults(ctx, outcomes), nil
+	}
+
+	hashesByClient := make(map[*qbClient.Client][]string)
+	clients := make([]*qbClient.Client, 0)
+	for _, outcome := range outcomes {
+		if outcome.err != nil {
+			continue
+		}
+		if _, exist := hashesByClient[outcome.client]; !exist {
+			clients = append(clients, outcome.client)
+		}
+		if !slices.Contains(hashesByClient[outcome.client], outcome.hash) {
+			hashesByClient[outcome.client] = append(hashesByClient[outcome.client], outcome.hash)
+		}
+	}
+
+	for _, client := range clients {
+		errL := apply(ctx, client, hashesByClient[client])
+		if errL == nil {
+			continue
+		}
+		failed = true
+		for i := range outcomes {
+			if outcomes[i].client == client && outcomes[i].err == nil {
+				outcomes[i].err = errL
+			}
+		}
+	}
+
+	return !failed, mutationResults(ctx, outcomes), nil
+}
+
+// emptyFilter reports whether filter has no conditions. A mistake like an unset filter in a client must not
+// select every torrent on every server.
+func emptyFilter(filter gqlGenerated.TorrentFilter) bool {
+	return len(filter.Servers) == 0 && len(filter.Categories) == 0 && len(filter.Tags) == 0 &&
+		len(filter.States) == 0 && len(filter.Trackers) == 0 &&
+		(filter.Expression == nil || strings.TrimSpace(*filter.Expression) == "") &&
+		(filter.Search == nil || strings.TrimSpace(*filter.Search) == "")
+}
+
+// filterTorrents returns an outcome for every torrent matching filter.
+func filterTorrents(ctx context.Context, filter gqlGenerated.TorrentFilter) ([]torrentOutcome, error) {
+	torrents, err := matchingTorrents(ctx, torrentsArgs{
+		categories: filter.Categories,
+		servers:    filter.Servers,
+		filter:     filter.Expression,
+		search:     filter.Search,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	trackers := make([]string, len(filter.Trackers))
+	for i, tracker := range filter.Trackers {
+		trackers[i] = strings.ToLower(tracker)
+	}
+
+	rtnMe := make([]torrentOutcome, 0)
+	for _, torrent := range torrents {
+		if len(filter.Tags) > 0 && !slices.ContainsFunc(torrent.TagList(), func(tag string) bool {
+			return slices.Contains(filter.Tags, tag)
+		}) {
+			continue
+		}
+		if len(filter.States) > 0 && !slices.Contains(filter.States, gqlGenerated.TorrentState(torrent.State)) {
+			continue
+		}
+		if len(trackers) > 0 && !slices.Contains(trackers, helpers.TrackerDomain(torrent.Tracker)) {
+			continue
+		}
+
+		rtnMe = append(rtnMe, torrentOutcome{server: torrent.Client.Name, hash: torrent.Hash, client: torrent.Client})
+	}
+
+	return rtnMe, nil
+}
+
+// checkTorrents returns an outcome per target and server that has it, or a failed one when the server
+// can't be resolved or asked, or none of the servers has the torrent.
+func checkTorrents(ctx context.Context, targets []torrentTarget) []torrentOutcome {
+	clientsByServer := make(map[string][]*qbClient.Client)
+	resolveErrs := make(map[string]error)
+	hashesToCheck := make(map[*qbClient.Client][]string)
+
+	for _, target := range targets {
+		clients, resolved := clientsByServer[target.server]
+		_, failed := resolveErrs[target.server]
+		if !resolved && !failed {
+			var err error
+			clients, err = qbClient.Registry().Resolve([]string{target.server})
+			if err != nil {
+				resolveErrs[target.server] = err
+				continue
+			}
+			clientsByServer[target.server] = clients
+		}
+
+		for _, client := range clients {
+			hashesToCheck[client] = append(hashesToCheck[client], target.hash)
+		}
+	}
+
+	// found has the lower case hashes each server has, checkErrs the servers that couldn't be asked.
+	found := make(map[*qbClient.Client]map[string]bool)
+	checkErrs := make(map[*qbClient.Client]error)
+	for client, hashes := range hashesToCheck {
+		torrents, err := client.GetTorrentsByHash(ctx, hashes)
+		if err != nil {
+			checkErrs[client] = err
+			continue
+		}
+		found[client] = make(map[string]bool)
+		for _, torrent := range torrents {
+			found[client][strings.ToLower(torrent.Hash)] = true
+		}
+	}
+
+	rtnMe := make([]torrentOutcome, 0, len(targets))
+	for _, target := range targets {
+		if err, exist := resolveErrs[target.server]; exist {
+			rtnMe = append(rtnMe, torrentOutcome{server: target.server, hash: target.hash, err: err})
+			continue
+		}
+
+		matched := false
+		for _, client := range clientsByServer[target.server] {
+			if err, exist := checkErrs[client]; exist {
+				rtnMe = append(rtnMe, torrentOutcome{server: client.Name, hash: target.hash, client: client, err: err})
+				matched = true
+			} else if found[client][strings.ToLower(target.hash)] {
+				rtnMe = append(rtnMe, torrentOutcome{server: client.Name, hash: target.hash, client: client})
+				matched = true
+			}
+		}
+		if !matched {
+			rtnMe = append(rtnMe, torrentOutcome{server: target.server, hash: target.hash, err: qbClient.TorrentNotFoundError})
+		}
+	}
+
+	return rtnMe
+}
+
+func mutationResults(ctx context.Context, outcomes []torrentOutcome) []gqlGenerated.TorrentMutationResult {
+	rtnMe := make([]gqlGenerated.TorrentMutationResult, len(outcomes))
+	for i, outcome := range outcomes {
+		rtnMe[i] = gqlGenerated.TorrentMutationResult{
+			Server: outcome.server,
+			Hash:   outcome.hash,
+			Ok:     outcome.err == nil,
+		}
+		if outcome.err != nil {
+			code, message := resultError(ctx, outcome.err)
+			rtnMe[i].ErrorCode = &code
+			rtnMe[i].Message = &message
+		}
+	}
+	return rtnMe
+}
+
+// resultError returns the code and message of an error in a mutation result. In production the message is
+// replaced the way ProductionErrorPresenter replaces it.
+func resultError(ctx context.Context, err error) (string, string) {
+	code := errorCode(err)
+	if errors.Is(err, errNotApplied) {
+		code = ErrCodeNotApplied
+	}
+	message := err.Error()
+
+	config := configuration.MustGetConfig()
+	if config.GetEnv() == configuration.EnvProd {
+		if replacement, replace := productionMessages[code]; replace {
+			message = replacement
+		} else if code == "" {
+			message = "internal server error"
+		}
+		if message != err.Error() {
+			slog.ErrorContext(ctx, "Mutation error", "code", code, "error", err)
+		}
+	}
+
+	if code == "" {
+		code = ErrCodeInternal
+	}
+	return code, message
+}
+
+// shareLimitsGiven reports whether args sets any of the share limits.
+func shareLimitsGiven(args gqlGenerated.SetTorrentLimitsArgs) bool {
+	return args.RatioLimit != nil || args.SeedingTimeLimitMinutes != nil || args.InactiveSeedingTimeLimitMinutes != nil
+}
+
+// setShareLimits sets the share limits given in args. qBittorrent only sets the three together, so the ones not
+// given keep each torrent's current value, and torrents are sent in groups that end up with the same limits.
+func setShareLimits(ctx context.Context, client *qbClient.Client, hashes []string, args gqlGenerated.SetTorrentLimitsArgs) error {
+	torrents, err := client.GetTorrentsByHash(ctx, hashes)
+	if err != nil {
+		return err
+	}
+
+	hashesByLimits := make(map[qbClient.ShareLimits][]string)
+	order := make([]qbClient.ShareLimits, 0)
+	for _, torrent := range torrents {
+		limits := qbClient.ShareLimits{
+			RatioLimit:               torrent.RatioLimit,
+			SeedingTimeLimit:         torrent.SeedingTimeLimit,
+			InactiveSeedingTimeLimit: torrent.InactiveSeedingTimeLimit,
+		}
+		if args.RatioLimit != nil {
+			limits.RatioLimit = *args.RatioLimit
+		}
+		if args.SeedingTimeLimitMinutes != nil {
+			limits.SeedingTimeLimit = *args.SeedingTimeLimitMinutes
+		}
+		if args.InactiveSeedingTimeLimitMinutes != nil {
+			limits.InactiveSeedingTimeLimit = *args.InactiveSeedingTimeLimitMinutes
+		}
+
+		if _, exist := hashesByLimits[limits]; !exist {
+			order = append(order, limits)
+		}
+		hashesByLimits[limits] = append(hashesByLimits[limits], torrent.Hash)
+	}
+
+	for _, limits := range order {
+		errL := client.SetShareLimits(ctx, hashesByLimits[limits], limits)
+		if errL != nil {
+			return errL
+		}
+	}
+	return nil
+}
@@ -0,0 +1,351 @@
+package gqlResolvers
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/filterExpr"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/gqlGenerated"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+// maxPageSize caps first and last, a client wanting everything can use the Torrents query.
+const maxPageSize = 1000
+
+var errBadUserInput = errors.New("bad input")
+
+type torrentsArgs struct {
+	categories []string
+	servers    []string
+	filter     *string
+	search     *string
+	sortBy     *gqlGenerated.TorrentSortField
+	order      *gqlGenerated.SortOrder
+}
+
+// listTorrents returns the torrents matching args, sorted.
+func listTorrents(ctx context.Context, args torrentsArgs) ([]gqlGenerated.Torrent, error) {
+	torrents, err := matchingTorrents(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	rtnMe := make([]gqlGenerated.Torrent, 0, len(torrents))
+	for _, torrent := range torrents {
+		rtnMe = append(rtnMe, torrentToGql(torrent))
+	}
+
+	sortBy, order := sortArgs(args.sortBy, args.order)
+	slices.SortFunc(rtnMe, func(a, b gqlGenerated.Torrent) int {
+		return compareSortKeys(newSortKey(&a, sortBy), newSortKey(&b, sortBy), order)
+	})
+
+	return rtnMe, nil
+}
+
+// matchingTorrents returns the torrents matching args in server order, sortBy and order are ignored.
+func matchingTorrents(ctx context.Context, args torrentsArgs) ([]*qbClient.TorrentInfo, error) {
+	var expr *filterExpr.Expr
+	if args.filter != nil && *args.filter != "" {
+		var err error
+		expr, err = filterExpr.Parse(*args.filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var words []string
+	if args.search != nil {
+		words = strings.Fields(strings.ToLower(*args.search))
+	}
+
+	var qbClients []*qbClient.Client
+
+	if len(args.servers) == 0 {
+		qbClients = qbClient.Registry().All()
+	} else {
+		var err error
+		qbClients, err = qbClient.Registry().Resolve(args.servers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rtnMe := make([]*qbClient.TorrentInfo, 0)
+
+	for _, client := range qbClients {
+		torrents, errL := client.GetTorrents(ctx)
+		if errL != nil {
+			return nil, errL
+		}
+
+		for _, torrent := range torrents {
+			match := slices.Contains(args.categories, torrent.Category)
+			if !match && len(args.categories) > 0 {
+				continue
+			}
+			if !expr.Match(torrent) {
+				continue
+			}
+			if !containsWords(torrent.Name, words) {
+				continue
+			}
+
+			rtnMe = append(rtnMe, torrent)
+		}
+	}
+
+	return rtnMe, nil
+}
+
+// containsWords reports whether name contains every word, words must be lower case.
+func containsWords(name string, words []string) bool {
+	name = strings.ToLower(name)
+	for _, word := range words {
+		if !strings.Contains(name, word) {
+			return false
+		}
+	}
+	return true
+}
+
+func sortArgs(sortBy *gqlGenerated.TorrentSortField, order *gqlGenerated.SortOrder) (gqlGenerated.TorrentSortField, gqlGenerated.SortOrder) {
+	rtnSortBy, rtnOrder := gqlGenerated.TorrentSortFieldServer, gqlGenerated.SortOrderAsc
+	if sortBy != nil {
+		rtnSortBy = *sortBy
+	}
+	if order != nil {
+		rtnOrder = *order
+	}
+	return rtnSortBy, rtnOrder
+}
+
+// sortKey is where a torrent sorts, it's also the content of a cursor. Torrents with the same Value or Number
+// are ordered by Name, Server then Hash, which is unique, so every torrent has its own place. Null is set when
+// the torrent has no value for a nullable field.
+type sortKey struct {
+	SortBy gqlGenerated.TorrentSortField `json:"sortBy"`
+	Order  gqlGenerated.SortOrder        `json:"order"`
+	Value  string                        `json:"value,omitempty"`
+	Number float64                       `json:"number,omitempty"`
+	Null   bool                          `json:"null,omitempty"`
+	Name   string                        `json:"name"`
+	Server string                        `json:"server"`
+	Hash   string                        `json:"hash"`
+}
+
+func newSortKey(torrent *gqlGenerated.Torrent, sortBy gqlGenerated.TorrentSortField) sortKey {
+	rtnMe := sortKey{SortBy: sortBy, Name: torrent.Name, Server: torrent.Server, Hash: torrent.InfoHashV1}
+
+	switch sortBy {
+	case gqlGenerated.TorrentSortFieldServer:
+		rtnMe.Value = torrent.Server
+	case gqlGenerated.TorrentSortFieldName:
+		rtnMe.Value = torrent.Name
+	case gqlGenerated.TorrentSortFieldCategory:
+		rtnMe.Value = torrent.Category
+	case gqlGenerated.TorrentSortFieldTags:
+		rtnMe.Value = strings.Join(torrent.Tags, ",")
+	case gqlGenerated.TorrentSortFieldState:
+		rtnMe.Value = string(torrent.State)
+	case gqlGenerated.TorrentSortFieldComment:
+		rtnMe.Value = torrent.Comment
+	case gqlGenerated.TorrentSortFieldRootPath:
+		rtnMe.Value = torrent.RootPath
+	case gqlGenerated.TorrentSortFieldSavePath:
+		rtnMe.Value = torrent.SavePath
+	case gqlGenerated.TorrentSortFieldContentPath:
+		rtnMe.Value = torrent.ContentPath
+	case gqlGenerated.TorrentSortFieldTrackerURL:
+		rtnMe.Value = torrent.TrackerURL
+	case gqlGenerated.TorrentSortFieldInfoHashV1:
+		rtnMe.Value = torrent.InfoHashV1
+	case gqlGenerated.TorrentSortFieldProgress:
+		rtnMe.Number = torrent.Progress
+	case gqlGenerated.TorrentSortFieldRatio:
+		rtnMe.Number = torrent.Ratio
+	case gqlGenerated.TorrentSortFieldAvailability:
+		rtnMe.Number = torrent.Availability
+	case gqlGenerated.TorrentSortFieldPopularity:
+		rtnMe.Number = torrent.Popularity
+	case gqlGenerated.TorrentSortFieldEtaSeconds:
+		if torrent.EtaSeconds == nil {
+			rtnMe.Null = true
+		} else {
+			rtnMe.Number = float64(*torrent.EtaSeconds)
+		}
+	case gqlGenerated.TorrentSortFieldDownloadSpeed:
+		rtnMe.Number = float64(torrent.DownloadSpeed)
+	case gqlGenerated.TorrentSortFieldUploadSpeed:
+		rtnMe.Number = float64(torrent.UploadSpeed)
+	case gqlGenerated.TorrentSortFieldSizeBytes:
+		rtnMe.Number = float64(torrent.SizeBytes)
+	case gqlGenerated.TorrentSortFieldTotalSizeBytes:
+		rtnMe.Number = float64(torrent.TotalSizeBytes)
+	case gqlGenerated.TorrentSortFieldAmountLeftBytes:
+		rtnMe.Number = float64(torrent.AmountLeftBytes)
+	case gqlGenerated.TorrentSortFieldUploadedBytes:
+		rtnMe.Number = float64(torrent.UploadedBytes)
+	case gqlGenerated.TorrentSortFieldDownloadedBytes:
+		rtnMe.Number = float64(torrent.DownloadedBytes)
+	case gqlGenerated.TorrentSortFieldSeeds:
+		rtnMe.Number = float64(torrent.Seeds)
+	case gqlGenerated.TorrentSortFieldLeeches:
+		rtnMe.Number = float64(torrent.Leeches)
+	case gqlGenerated.TorrentSortFieldAddedOn:
+		rtnMe.Number = float64(torrent.AddedOn)
+	case gqlGenerated.TorrentSortFieldCompletedAt:
+		rtnMe.setTime(torrent.CompletedAt)
+	case gqlGenerated.TorrentSortFieldLastActivityAt:
+		rtnMe.setTime(torrent.LastActivityAt)
+	case gqlGenerated.TorrentSortFieldSeenCompleteAt:
+		rtnMe.setTime(torrent.SeenCompleteAt)
+	case gqlGenerated.TorrentSortFieldTimeActiveSeconds:
+		rtnMe.Number = float64(torrent.TimeActiveSeconds)
+	case gqlGenerated.TorrentSortFieldSeedingTimeSeconds:
+		rtnMe.Number = float64(torrent.SeedingTimeSeconds)
+	case gqlGenerated.TorrentSortFieldQueuePosition:
+		rtnMe.Number = float64(torrent.QueuePosition)
+	}
+
+	return rtnMe
+}
+
+// setTime sorts by t in seconds, qBittorrent doesn't report anything finer.
+func (k *sortKey) setTime(t *time.Time) {
+	if t == nil {
+		k.Null = true
+		return
+	}
+	k.Number = float64(t.Unix())
+}
+
+// compareSortKeys orders text case insensitive, DESC reverses the whole order including the tie breaks.
+// Nulls are last in both orders.
+func compareSortKeys(a, b sortKey, order gqlGenerated.SortOrder) int {
+	if a.Null != b.Null {
+		if a.Null {
+			return 1
+		}
+		return -1
+	}
+
+	rtnMe := cmp.Or(
+		cmp.Compare(a.Number, b.Number),
+		compareText(a.Value, b.Value),
+		compareText(a.Name, b.Name),
+		strings.Compare(a.Server, b.Server),
+		strings.Compare(a.Hash, b.Hash),
+	)
+
+	if order == gqlGenerated.SortOrderDesc {
+		return -rtnMe
+	}
+	return rtnMe
+}
+
+func compareText(a, b string) int {
+	return cmp.Or(strings.Compare(strings.ToLower(a), strings.ToLower(b)), strings.Compare(a, b))
+}
+
+func encodeCursor(key sortKey) string {
+	b, _ := json.Marshal(key)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(cursor string, sortBy gqlGenerated.TorrentSortField, order gqlGenerated.SortOrder) (sortKey, error) {
+	var rtnMe sortKey
+
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err == nil {
+		err = json.Unmarshal(b, &rtnMe)
+	}
+	if err != nil {
+		return sortKey{}, fmt.Errorf("%w: %q isn't a cursor", errBadUserInput, cursor)
+	}
+
+	if rtnMe.SortBy != sortBy || rtnMe.Order != order {
+		return sortKey{}, fmt.Errorf("%w: the cursor is for sortBy %s order %s", errBadUserInput, rtnMe.SortBy, rtnMe.Order)
+	}
+	return rtnMe, nil
+}
+
+type pageArgs struct {
+	first  *int
+	after  *string
+	last   *int
+	before *string
+}
+
+// paginate returns the page of sorted torrents between the after and before cursors, the first or last of them.
+func paginate(torrents []gqlGenerated.Torrent, sortBy gqlGenerated.TorrentSortField, order gqlGenerated.SortOrder, args pageArgs) (*gqlGenerated.TorrentConnection, error) {
+	if args.first != nil && (*args.first < 0 || *args.first > maxPageSize) {
+		return nil, fmt.Errorf("%w: first must be between 0 and %d", errBadUserInput, maxPageSize)
+	}
+	if args.last != nil && (*args.last < 0 || *args.last > maxPageSize) {
+		return nil, fmt.Errorf("%w: last must be between 0 and %d", errBadUserInput, maxPageSize)
+	}
+
+	keys := make([]sortKey, len(torrents))
+	for i := range torrents {
+		keys[i] = newSortKey(&torrents[i], sortBy)
+		keys[i].Order = order
+	}
+
+	start, end := 0, len(torrents)
+	if args.after != nil {
+		after, err := decodeCursor(*args.after, sortBy, order)
+		if err != nil {
+			return nil, err
+		}
+		start, _ = slices.BinarySearchFunc(keys, after, func(key, target sortKey) int {
+			return cmp.Or(compareSortKeys(key, target, order), -1)
+		})
+	}
+	if args.before != nil {
+		before, err := decodeCursor(*args.before, sortBy, order)
+		if err != nil {
+			return nil, err
+		}
+		end, _ = slices.BinarySearchFunc(keys, before, func(key, target sortKey) int {
+			return compareSortKeys(key, target, order)
+		})
+		end = max(end, start)
+	}
+
+	if args.first != nil {
+		end = min(end, start+*args.first)
+	}
+	if args.last != nil {
+		start = max(start, end-*args.last)
+	}
+
+	rtnMe := &gqlGenerated.TorrentConnection{
+		Edges: make([]gqlGenerated.TorrentEdge, 0, end-start),
+		PageInfo: &gqlGenerated.PageInfo{
+			HasNextPage:     end < len(torrents),
+			HasPreviousPage: start > 0,
+		},
+		TotalCount: len(torrents),
+	}
+
+	for i := start; i < end; i++ {
+		rtnMe.Edges = append(rtnMe.Edges, gqlGenerated.TorrentEdge{
+			Cursor: encodeCursor(keys[i]),
+			Node:   &torrents[i],
+		})
+	}
+	if len(rtnMe.Edges) > 0 {
+		rtnMe.PageInfo.StartCursor = &rtnMe.Edges[0].Cursor
+		rtnMe.PageInfo.EndCursor = &rtnMe.Edges[len(rtnMe.Edges)-1].Cursor
+	}
+
+	return rtnMe, nil
+}
@@ -3,11 +3,12 @@ package gqlResolvers
 // This file will be automatically regenerated based on the schema, any resolver
 // implementations
 // will be copied through when generating and any unknown code will be moved to the end.
-// Code generated by github.com/99designs/gqlgen version v0.17.93
+// Code generated by github.com/99designs/gqlgen version v0.17.94
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/kingsukhoi/qbitorrent-panel/pkg/gqlGenerated"
 	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
@@ -15,9 +16,9 @@ import (
 
 // CreateCategory is the resolver for the createCategory field.
 func (r *mutationResolver) CreateCategory(ctx context.Context, args gqlGenerated.CreateCategoryArgs) (*gqlGenerated.CreateCategoryResult, error) {
-	client, found := qbClient.Registry().Get(args.Server)
-	if !found {
-		return nil, errors.New("client not found")
+	clients, err := qbClient.Registry().Resolve([]string{args.Server})
+	if err != nil {
+		return nil, err
 	}
 
 	currCategory := &qbClient.Category{
@@ -25,9 +26,11 @@ func (r *mutationResolver) CreateCategory(ctx context.Context, args gqlGenerated
 		SavePath: args.Path,
 	}
 
-	err := client.CreateCategoryIfNotExist(ctx, currCategory)
-	if err != nil {
-		return nil, err
+	for _, client := range clients {
+		errL := client.CreateCategoryIfNotExist(ctx, currCategory)
+		if errL != nil {
+			return nil, errL
+		}
 	}
 
 	return &gqlGenerated.CreateCategoryResult{Success: true}, nil
@@ -35,70 +38,201 @@ func (r *mutationResolver) CreateCategory(ctx context.Context, args gqlGenerated
 
 // PauseTorrents is the resolver for the pauseTorrents field.
 func (r *mutationResolver) PauseTorrents(ctx context.Context, args gqlGenerated.PauseTorrentsArgs) (*gqlGenerated.PauseTorrentsResults, error) {
-	torrentsToPause := make(map[string][]string)
+	success, results, err := applyToTorrents(ctx, bulkArgs{
+		torrents: targetsOf(args.Torrents, func(torrent *gqlGenerated.PauseTorrentInfo) torrentTarget {
+			return torrentTarget{server: torrent.Server, hash: torrent.Hash}
+		}),
+		filter: args.Filter,
+		atomic: args.Atomic,
+		dryRun: args.DryRun,
+	}, func(ctx context.Context, client *qbClient.Client, hashes []string) error {
+		return client.PauseTorrents(ctx, hashes)
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	for _, currTorrent := range args.Torrents {
-		torrentsToPause[currTorrent.Server] = append(torrentsToPause[currTorrent.Server], currTorrent.Hash)
+	return &gqlGenerated.PauseTorrentsResults{Success: success, Results: results}, nil
+}
+
+// ResumeTorrents is the resolver for the resumeTorrents field.
+func (r *mutationResolver) ResumeTorrents(ctx context.Context, args gqlGenerated.ResumeTorrentsArgs) (*gqlGenerated.ResumeTorrentsResults, error) {
+	success, results, err := applyToTorrents(ctx, bulkArgs{
+		torrents: targetsOf(args.Torrents, func(torrent *gqlGenerated.ResumeTorrentInfo) torrentTarget {
+			return torrentTarget{server: torrent.Server, hash: torrent.Hash}
+		}),
+		filter: args.Filter,
+		atomic: args.Atomic,
+		dryRun: args.DryRun,
+	}, func(ctx context.Context, client *qbClient.Client, hashes []string) error {
+		return client.ResumeTorrents(ctx, hashes)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	for server, hashes := range torrentsToPause {
-		client, exist := qbClient.Registry().Get(server)
-		if !exist {
-			return nil, errors.New("server not found in registry")
-		}
+	return &gqlGenerated.ResumeTorrentsResults{Success: success, Results: results}, nil
+}
 
-		errL := client.PauseTorrents(ctx, hashes)
-		if errL != nil {
-			return nil, errL
-		}
+// DeleteTorrents is the resolver for the deleteTorrents field.
+func (r *mutationResolver) DeleteTorrents(ctx context.Context, args gqlGenerated.DeleteTorrentsArgs) (*gqlGenerated.DeleteTorrentsResults, error) {
+	success, results, err := applyToTorrents(ctx, bulkArgs{
+		torrents: targetsOf(args.Torrents, func(torrent *gqlGenerated.DeleteTorrentInfo) torrentTarget {
+			return torrentTarget{server: torrent.Server, hash: torrent.Hash}
+		}),
+		filter: args.Filter,
+		atomic: args.Atomic,
+		dryRun: args.DryRun,
+	}, func(ctx context.Context, client *qbClient.Client, hashes []string) error {
+		return client.DeleteTorrent(ctx, hashes, args.DeleteFiles)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return &gqlGenerated.PauseTorrentsResults{Success: true}, nil
+	return &gqlGenerated.DeleteTorrentsResults{Success: success, Results: results}, nil
 }
 
-// ResumeTorrents is the resolver for the resumeTorrents field.
-func (r *mutationResolver) ResumeTorrents(ctx context.Context, args gqlGenerated.ResumeTorrentsArgs) (*gqlGenerated.ResumeTorrentsResults, error) {
-	torrentsToResume := make(map[string][]string)
+// MoveTorrentsInQueue is the resolver for the moveTorrentsInQueue field.
+func (r *mutationResolver) MoveTorrentsInQueue(ctx context.Context, args gqlGenerated.MoveTorrentsInQueueArgs) (*gqlGenerated.MoveTorrentsInQueueResults, error) {
+	success, results, err := applyToTorrents(ctx, bulkArgs{
+		torrents: targetsOf(args.Torrents, func(torrent *gqlGenerated.QueueTorrentInfo) torrentTarget {
+			return torrentTarget{server: torrent.Server, hash: torrent.Hash}
+		}),
+		filter: args.Filter,
+		atomic: args.Atomic,
+		dryRun: args.DryRun,
+	}, func(ctx context.Context, client *qbClient.Client, hashes []string) error {
+		switch args.Move {
+		case gqlGenerated.QueueMoveUp:
+			return client.IncreasePriority(ctx, hashes)
+		case gqlGenerated.QueueMoveDown:
+			return client.DecreasePriority(ctx, hashes)
+		case gqlGenerated.QueueMoveTop:
+			return client.TopPriority(ctx, hashes)
+		case gqlGenerated.QueueMoveBottom:
+			return client.BottomPriority(ctx, hashes)
+		default:
+			return fmt.Errorf("unknown queue move %s", args.Move)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	for _, currTorrent := range args.Torrents {
-		torrentsToResume[currTorrent.Server] = append(torrentsToResume[currTorrent.Server], currTorrent.Hash)
+	return &gqlGenerated.MoveTorrentsInQueueResults{Success: success, Results: results}, nil
+}
+
+// TagTorrents is the resolver for the tagTorrents field.
+func (r *mutationResolver) TagTorrents(ctx context.Context, args gqlGenerated.TagTorrentsArgs) (*gqlGenerated.TagTorrentsResults, error) {
+	if len(args.Add) == 0 && len(args.Remove) == 0 {
+		return nil, fmt.Errorf("%w: give tags to Add or Remove", errBadUserInput)
 	}
 
-	for server, hashes := range torrentsToResume {
-		client, exist := qbClient.Registry().Get(server)
-		if !exist {
-			return nil, errors.New("server not found in registry")
+	success, results, err := applyToTorrents(ctx, bulkArgs{
+		torrents: targetsOf(args.Torrents, func(torrent *gqlGenerated.TagTorrentInfo) torrentTarget {
+			return torrentTarget{server: torrent.Server, hash: torrent.Hash}
+		}),
+		filter: args.Filter,
+		atomic: args.Atomic,
+		dryRun: args.DryRun,
+	}, func(ctx context.Context, client *qbClient.Client, hashes []string) error {
+		if len(args.Add) > 0 {
+			errL := client.AddTags(ctx, hashes, args.Add)
+			if errL != nil {
+				return errL
+			}
 		}
-
-		errL := client.ResumeTorrents(ctx, hashes)
-		if errL != nil {
-			return nil, errL
+		if len(args.Remove) > 0 {
+			return client.RemoveTags(ctx, hashes, args.Remove)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return &gqlGenerated.ResumeTorrentsResults{Success: true}, nil
+	return &gqlGenerated.TagTorrentsResults{Success: success, Results: results}, nil
 }
 
-// DeleteTorrents is the resolver for the deleteTorrents field.
-func (r *mutationResolver) DeleteTorrents(ctx context.Context, args gqlGenerated.DeleteTorrentsArgs) (*gqlGenerated.DeleteTorrentsResults, error) {
-	torrentsToDelete := make(map[string][]string)
+// RecheckTorrents is the resolver for the recheckTorrents field.
+func (r *mutationResolver) RecheckTorrents(ctx context.Context, args gqlGenerated.RecheckTorrentsArgs) (*gqlGenerated.RecheckTorrentsResults, error) {
+	success, results, err := applyToTorrents(ctx, bulkArgs{
+		torrents: targetsOf(args.Torrents, func(torrent *gqlGenerated.RecheckTorrentInfo) torrentTarget {
+			return torrentTarget{server: torrent.Server, hash: torrent.Hash}
+		}),
+		filter: args.Filter,
+		atomic: args.Atomic,
+		dryRun: args.DryRun,
+	}, func(ctx context.Context, client *qbClient.Client, hashes []string) error {
+		return client.RecheckTorrents(ctx, hashes)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlGenerated.RecheckTorrentsResults{Success: success, Results: results}, nil
+}
+
+// MoveTorrents is the resolver for the moveTorrents field.
+func (r *mutationResolver) MoveTorrents(ctx context.Context, args gqlGenerated.MoveTorrentsArgs) (*gqlGenerated.MoveTorrentsResults, error) {
+	if strings.TrimSpace(args.Location) == "" {
+		return nil, fmt.Errorf("%w: Location can't be empty", errBadUserInput)
+	}
+
+	success, results, err := applyToTorrents(ctx, bulkArgs{
+		torrents: targetsOf(args.Torrents, func(torrent *gqlGenerated.LocationTorrentInfo) torrentTarget {
+			return torrentTarget{server: torrent.Server, hash: torrent.Hash}
+		}),
+		filter: args.Filter,
+		atomic: args.Atomic,
+		dryRun: args.DryRun,
+	}, func(ctx context.Context, client *qbClient.Client, hashes []string) error {
+		return client.SetLocation(ctx, hashes, args.Location)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlGenerated.MoveTorrentsResults{Success: success, Results: results}, nil
+}
 
-	for _, currTorrent := range args.Torrents {
-		torrentsToDelete[currTorrent.Server] = append(torrentsToDelete[currTorrent.Server], currTorrent.Hash)
+// SetTorrentLimits is the resolver for the setTorrentLimits field.
+func (r *mutationResolver) SetTorrentLimits(ctx context.Context, args gqlGenerated.SetTorrentLimitsArgs) (*gqlGenerated.SetTorrentLimitsResults, error) {
+	if args.DownloadLimit == nil && args.UploadLimit == nil && !shareLimitsGiven(args) {
+		return nil, fmt.Errorf("%w: give at least one limit", errBadUserInput)
 	}
 
-	for server, hashes := range torrentsToDelete {
-		client, exist := qbClient.Registry().Get(server)
-		if !exist {
-			return nil, errors.New("server not found in registry")
+	success, results, err := applyToTorrents(ctx, bulkArgs{
+		torrents: targetsOf(args.Torrents, func(torrent *gqlGenerated.LimitTorrentInfo) torrentTarget {
+			return torrentTarget{server: torrent.Server, hash: torrent.Hash}
+		}),
+		filter: args.Filter,
+		atomic: args.Atomic,
+		dryRun: args.DryRun,
+	}, func(ctx context.Context, client *qbClient.Client, hashes []string) error {
+		if args.DownloadLimit != nil {
+			errL := client.SetDownloadLimit(ctx, hashes, *args.DownloadLimit)
+			if errL != nil {
+				return errL
+			}
 		}
-		err := client.DeleteTorrent(ctx, hashes, args.DeleteFiles)
-		if err != nil {
-			return nil, err
+		if args.UploadLimit != nil {
+			errL := client.SetUploadLimit(ctx, hashes, *args.UploadLimit)
+			if errL != nil {
+				return errL
+			}
 		}
+		if shareLimitsGiven(args) {
+			return setShareLimits(ctx, client, hashes, args)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return &gqlGenerated.DeleteTorrentsResults{Success: true}, nil
+	return &gqlGenerated.SetTorrentLimitsResults{Success: success, Results: results}, nil
 }
 
 // Mutation returns gqlGenerated.MutationResolver implementation.
@@ -0,0 +1,40 @@
+package handleOutputs
+
+import (
+	"io"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/helpers"
+)
+
+func PrintEndpointChecks(w io.Writer, outputType string, checks []helpers.EndpointCheck) error {
+
+	switch outputType {
+	case "json":
+		return writeJSON(w, "endpointChecks", checks)
+	default:
+		return printEndpointCheckTable(w, outputType, checks)
+	}
+
+}
+
+func printEndpointCheckTable(w io.Writer, outputType string, input []helpers.EndpointCheck) error {
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Server", "Path", "Version", "Status"})
+
+	for _, i := range input {
+		status := "ok"
+		if i.Error != "" {
+			status = i.Error
+		}
+
+		t.AppendRow(table.Row{
+			i.Server,
+			i.Path,
+			i.Version,
+			status,
+		})
+	}
+
+	return writeTable(w, outputType, t)
+}
@@ -0,0 +1,55 @@
+package handleOutputs
+
+import (
+	_ "embed"
+	"encoding/json"
+	"io"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// SchemaVersion is the version of the JSON output, see schema.json. It's bumped when a field is removed, renamed
+// or changes type. Adding a field doesn't bump it, so scripts should ignore fields they don't know.
+const SchemaVersion = 1
+
+// Schema is the JSON Schema of Document for every kind of output.
+//
+//go:embed schema.json
+var Schema []byte
+
+// Document is the JSON output of every command.
+type Document[T any] struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Kind          string `json:"kind"`
+	Items         []T    `json:"items"`
+}
+
+func writeJSON[T any](w io.Writer, kind string, items []T) error {
+	if items == nil {
+		items = make([]T, 0)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(Document[T]{SchemaVersion: SchemaVersion, Kind: kind, Items: items})
+}
+
+// writeTable renders t as outputType, anything that isn't csv, tsv or markdown is a table.
+func writeTable(w io.Writer, outputType string, t table.Writer) error {
+	var output string
+
+	switch outputType {
+	default:
+		output = t.Render()
+	case "csv":
+		output = t.RenderCSV()
+	case "tsv":
+		output = t.RenderTSV()
+	case "markdown":
+		output = t.RenderMarkdown()
+	}
+
+	_, err := io.WriteString(w, output+"\n")
+	return err
+}
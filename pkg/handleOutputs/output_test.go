@@ -0,0 +1,254 @@
+package handleOutputs_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/handleOutputs"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/helpers"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+var outputTypes = []string{"table", "csv", "tsv", "markdown", "json"}
+
+// checkGolden compares got to testdata/name.golden, run go test -update to accept a change on purpose.
+func checkGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s changed, run go test -update if that's on purpose\ngot:\n%s\nwant:\n%s", path, got, want)
+	}
+}
+
+func torrents() []*qbClient.TorrentInfo {
+	alpha := &qbClient.Client{Name: "alpha"}
+	beta := &qbClient.Client{Name: "beta"}
+
+	return []*qbClient.TorrentInfo{
+		{
+			Common:       qbClient.Common{Client: alpha},
+			Hash:         "8a19577fb5f690970ca43a57ff1011ae202244b8",
+			Name:         "debian-13.0.0-amd64-netinst.iso",
+			Category:     "linux",
+			Tags:         "iso, keep",
+			State:        "stalledUP",
+			Size:         792723456,
+			Progress:     1,
+			Ratio:        3.14159,
+			Uploaded:     2490368000,
+			Downloaded:   792723456,
+			Priority:     0,
+			Tracker:      "http://bttracker.debian.org:6969/announce",
+			SavePath:     "/data/linux",
+			ContentPath:  "/data/linux/debian-13.0.0-amd64-netinst.iso",
+			AddedOn:      qbClient.JSONTime(time.Unix(1754000000, 0)),
+			CompletionOn: qbClient.JSONTime(time.Unix(1754000600, 0)),
+		},
+		{
+			Common:       qbClient.Common{Client: alpha},
+			Hash:         "3b2e5a1c9d",
+			Name:         "Big, \"Quoted\" Name",
+			State:        "downloading",
+			Size:         1000,
+			Progress:     0.25,
+			Downloaded:   250,
+			Dlspeed:      4096,
+			Priority:     1,
+			SavePath:     "/data",
+			ContentPath:  "/data/Big, \"Quoted\" Name",
+			AddedOn:      qbClient.JSONTime(time.Unix(1754100000, 0)),
+			CompletionOn: qbClient.JSONTime(time.Unix(-1, 0)),
+		},
+		{
+			Common:       qbClient.Common{Client: beta},
+			Hash:         "c0ffee",
+			Name:         "archlinux-2025.08.01-x86_64.iso",
+			Category:     "linux",
+			State:        "stoppedUP",
+			Size:         1400000000,
+			Progress:     1,
+			Ratio:        0.5,
+			Uploaded:     700000000,
+			Downloaded:   1400000000,
+			SavePath:     "/srv/torrents",
+			ContentPath:  "/srv/torrents/archlinux-2025.08.01-x86_64.iso",
+			AddedOn:      qbClient.JSONTime(time.Unix(1754200000, 0)),
+			CompletionOn: qbClient.JSONTime(time.Unix(1754203600, 0)),
+		},
+	}
+}
+
+func TestPrintTorrentInfo(t *testing.T) {
+	for _, outputType := range outputTypes {
+		var buf bytes.Buffer
+		if err := handleOutputs.PrintTorrentInfo(&buf, outputType, torrents()); err != nil {
+			t.Fatal(err)
+		}
+		checkGolden(t, "torrents."+outputType, buf.Bytes())
+	}
+}
+
+func TestPrintEndpointChecks(t *testing.T) {
+	checks := []helpers.EndpointCheck{
+		{Server: "alpha", Path: "http://alpha:8080", Version: "2.11.2"},
+		{Server: "beta", Path: "http://beta:8080", Error: "unauthorized"},
+	}
+
+	for _, outputType := range outputTypes {
+		var buf bytes.Buffer
+		if err := handleOutputs.PrintEndpointChecks(&buf, outputType, checks); err != nil {
+			t.Fatal(err)
+		}
+		checkGolden(t, "endpointChecks."+outputType, buf.Bytes())
+	}
+}
+
+func TestPrintPreferenceDiffs(t *testing.T) {
+	diffs := []helpers.PreferenceDiff{
+		{Server: "alpha", Key: "max_active_uploads", Expected: 5, Actual: 10},
+		{Server: "alpha", Key: "save_path", Expected: "/data", Actual: "/downloads"},
+		{Server: "beta", Key: "web_ui_domain_list", Expected: "*", Actual: nil},
+	}
+
+	for _, outputType := range outputTypes {
+		var buf bytes.Buffer
+		if err := handleOutputs.PrintPreferenceDiffs(&buf, outputType, diffs); err != nil {
+			t.Fatal(err)
+		}
+		checkGolden(t, "preferenceDiffs."+outputType, buf.Bytes())
+	}
+}
+
+func TestPrintStats(t *testing.T) {
+	total, groups, err := helpers.GroupStats(torrents(), helpers.GroupByCategory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buckets, err := helpers.Histogram(torrents(), helpers.HistogramRatio, nil, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, outputType := range outputTypes {
+		var buf bytes.Buffer
+		if err = handleOutputs.PrintStatsGroups(&buf, outputType, total, groups); err != nil {
+			t.Fatal(err)
+		}
+		checkGolden(t, "statsGroups."+outputType, buf.Bytes())
+
+		buf.Reset()
+		if err = handleOutputs.PrintHistogram(&buf, outputType, buckets); err != nil {
+			t.Fatal(err)
+		}
+		checkGolden(t, "histogram."+outputType, buf.Bytes())
+	}
+}
+
+func TestEmptyJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := handleOutputs.PrintTorrentInfo(&buf, "json", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var document map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &document); err != nil {
+		t.Fatal(err)
+	}
+	if items, ok := document["items"].([]any); !ok || len(items) != 0 {
+		t.Errorf("items = %v, want []", document["items"])
+	}
+}
+
+// TestSchema keeps schema.json in sync with the output types, a field added to one has to be added to the other.
+func TestSchema(t *testing.T) {
+	var schema struct {
+		Properties struct {
+			SchemaVersion struct {
+				Const int
+			}
+		}
+		Defs map[string]struct {
+			Required   []string
+			Properties map[string]any
+		} `json:"$defs"`
+	}
+	if err := json.Unmarshal(handleOutputs.Schema, &schema); err != nil {
+		t.Fatal(err)
+	}
+
+	if schema.Properties.SchemaVersion.Const != handleOutputs.SchemaVersion {
+		t.Errorf("schema.json is version %d, SchemaVersion is %d", schema.Properties.SchemaVersion.Const, handleOutputs.SchemaVersion)
+	}
+
+	tests := []struct {
+		def    string
+		output any
+	}{
+		{"torrent", handleOutputs.Torrent{}},
+		{"endpointCheck", helpers.EndpointCheck{}},
+		{"preferenceDiff", helpers.PreferenceDiff{}},
+		{"statsGroup", helpers.StatsGroup{}},
+		{"histogramBucket", helpers.HistogramBucket{}},
+	}
+	for _, test := range tests {
+		def, exist := schema.Defs[test.def]
+		if !exist {
+			t.Errorf("schema.json has no $defs/%s", test.def)
+			continue
+		}
+
+		fields := jsonFields(reflect.TypeOf(test.output))
+		for name, omitempty := range fields {
+			if _, exist = def.Properties[name]; !exist {
+				t.Errorf("$defs/%s is missing %s", test.def, name)
+			}
+			if !omitempty && !slices.Contains(def.Required, name) {
+				t.Errorf("$defs/%s doesn't require %s", test.def, name)
+			}
+		}
+		if len(def.Properties) != len(fields) {
+			t.Errorf("$defs/%s has %d properties, %T has %d fields", test.def, len(def.Properties), test.output, len(fields))
+		}
+	}
+}
+
+// jsonFields returns the JSON name of every field of a struct type, including embedded ones, and whether it's
+// omitted when empty.
+func jsonFields(typ reflect.Type) map[string]bool {
+	rtnMe := make(map[string]bool)
+
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		switch {
+		case field.Anonymous:
+			maps.Copy(rtnMe, jsonFields(field.Type))
+		case field.IsExported():
+			name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+			rtnMe[name] = options == "omitempty"
+		}
+	}
+	return rtnMe
+}
@@ -0,0 +1,61 @@
+package handleOutputs
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/helpers"
+)
+
+func PrintPreferenceDiffs(w io.Writer, outputType string, diffs []helpers.PreferenceDiff) error {
+
+	switch outputType {
+	case "json":
+		return writeJSON(w, "preferenceDiffs", diffs)
+	default:
+		return printPreferenceDiffTable(w, outputType, diffs)
+	}
+
+}
+
+func printPreferenceDiffTable(w io.Writer, outputType string, input []helpers.PreferenceDiff) error {
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Server", "Key", "Expected", "Actual"})
+
+	lastHost := ""
+	if len(input) > 0 {
+		lastHost = input[0].Server
+	}
+
+	for _, i := range input {
+		if lastHost != i.Server {
+			t.AppendSeparator()
+			lastHost = i.Server
+		}
+
+		actual := "<missing>"
+		if i.Actual != nil {
+			actual = formatPreferenceValue(i.Actual)
+		}
+
+		t.AppendRow(table.Row{
+			i.Server,
+			i.Key,
+			formatPreferenceValue(i.Expected),
+			actual,
+		})
+	}
+
+	return writeTable(w, outputType, t)
+}
+
+func formatPreferenceValue(v any) string {
+	switch curr := v.(type) {
+	case string:
+		return curr
+	default:
+		b, _ := json.Marshal(curr)
+		return string(b)
+	}
+}
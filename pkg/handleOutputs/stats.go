@@ -0,0 +1,96 @@
+package handleOutputs
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/helpers"
+)
+
+// PrintStatsGroups prints a row per group. Tables and markdown end with the total, csv, tsv and json only have
+// the groups, a script can add them up.
+func PrintStatsGroups(w io.Writer, outputType string, total helpers.TorrentStats, groups []helpers.StatsGroup) error {
+
+	switch outputType {
+	case "json":
+		return writeJSON(w, "statsGroups", groups)
+	default:
+		return printStatsGroupsTable(w, outputType, total, groups)
+	}
+
+}
+
+func printStatsGroupsTable(w io.Writer, outputType string, total helpers.TorrentStats, input []helpers.StatsGroup) error {
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Group", "Count", "Size", "Uploaded", "Downloaded", "Avg Ratio"})
+
+	for _, i := range input {
+		t.AppendRow(statsRow(i.Key, i.TorrentStats))
+	}
+
+	if outputType == "table" || outputType == "markdown" {
+		t.AppendSeparator()
+		t.AppendRow(statsRow("Total", total))
+	}
+
+	return writeTable(w, outputType, t)
+}
+
+func statsRow(key string, stats helpers.TorrentStats) table.Row {
+	if key == "" {
+		key = "<none>"
+	}
+
+	return table.Row{
+		key,
+		stats.Count,
+		formatBytes(stats.Size),
+		formatBytes(stats.Uploaded),
+		formatBytes(stats.Downloaded),
+		fmt.Sprintf("%.2f", stats.AverageRatio),
+	}
+}
+
+func PrintHistogram(w io.Writer, outputType string, buckets []helpers.HistogramBucket) error {
+
+	switch outputType {
+	case "json":
+		return writeJSON(w, "histogram", buckets)
+	default:
+		return printHistogramTable(w, outputType, buckets)
+	}
+
+}
+
+func printHistogramTable(w io.Writer, outputType string, input []helpers.HistogramBucket) error {
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Bucket", "Count", "Size"})
+
+	for _, i := range input {
+		t.AppendRow(table.Row{
+			i.Label,
+			i.Count,
+			formatBytes(i.Size),
+		})
+	}
+
+	return writeTable(w, outputType, t)
+}
+
+// formatBytes formats n in binary units. Ex. 1.50 GiB
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	value := float64(n)
+	suffixes := []string{"KiB", "MiB", "GiB", "TiB", "PiB"}
+	i := -1
+	for value >= unit && i < len(suffixes)-1 {
+		value /= unit
+		i++
+	}
+	return fmt.Sprintf("%.2f %s", value, suffixes[i])
+}
@@ -1,59 +1,108 @@
 package handleOutputs
 
 import (
-	"encoding/json"
 	"fmt"
-	"net/url"
-	"os"
+	"io"
+	"time"
 
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
 )
 
-func PrintTorrentInfo(outputType string, torrentInfo []*qbClient.TorrentInfo) {
+// Torrent is a torrent in the JSON output. Sizes are bytes and speeds bytes per second.
+type Torrent struct {
+	Server      string     `json:"server"`
+	Hash        string     `json:"hash"`
+	Name        string     `json:"name"`
+	Category    string     `json:"category"`
+	Tags        []string   `json:"tags"`
+	State       string     `json:"state"`
+	Size        int64      `json:"size"`
+	Progress    float64    `json:"progress"`
+	Ratio       float64    `json:"ratio"`
+	Uploaded    int64      `json:"uploaded"`
+	Downloaded  int64      `json:"downloaded"`
+	UpSpeed     int        `json:"upSpeed"`
+	DownSpeed   int        `json:"downSpeed"`
+	Priority    int        `json:"priority"`
+	Tracker     string     `json:"tracker"`
+	SavePath    string     `json:"savePath"`
+	ContentPath string     `json:"contentPath"`
+	AddedOn     time.Time  `json:"addedOn"`
+	CompletedOn *time.Time `json:"completedOn"`
+}
 
-	switch outputType {
-	case "json":
-		printJson(torrentInfo)
-	default:
-		printTable(outputType, torrentInfo)
+func NewTorrent(torrent *qbClient.TorrentInfo) Torrent {
+	rtnMe := Torrent{
+		Hash:        torrent.Hash,
+		Name:        torrent.Name,
+		Category:    torrent.Category,
+		Tags:        torrent.TagList(),
+		State:       torrent.State,
+		Size:        torrent.Size,
+		Progress:    torrent.Progress,
+		Ratio:       torrent.Ratio,
+		Uploaded:    torrent.Uploaded,
+		Downloaded:  torrent.Downloaded,
+		UpSpeed:     torrent.Upspeed,
+		DownSpeed:   torrent.Dlspeed,
+		Priority:    torrent.Priority,
+		Tracker:     torrent.Tracker,
+		SavePath:    torrent.SavePath,
+		ContentPath: torrent.ContentPath,
+		AddedOn:     torrent.AddedOn.Time().UTC(),
+	}
+	if torrent.Client != nil {
+		rtnMe.Server = torrent.Client.Name
+	}
+
+	// qBittorrent sends -1 or 0 for torrents that haven't completed.
+	if completedOn := torrent.CompletionOn.Time(); completedOn.Unix() > 0 {
+		completedOn = completedOn.UTC()
+		rtnMe.CompletedOn = &completedOn
 	}
 
+	return rtnMe
 }
 
-func printJson(input []*qbClient.TorrentInfo) {
+func PrintTorrentInfo(w io.Writer, outputType string, torrentInfo []*qbClient.TorrentInfo) error {
 
-	output, _ := json.MarshalIndent(input, "", "  ")
-	fmt.Println(string(output))
+	switch outputType {
+	case "json":
+		torrents := make([]Torrent, 0, len(torrentInfo))
+		for _, torrent := range torrentInfo {
+			torrents = append(torrents, NewTorrent(torrent))
+		}
+		return writeJSON(w, "torrents", torrents)
+	default:
+		return printTable(w, outputType, torrentInfo)
+	}
 
 }
 
-func printTable(outputType string, input []*qbClient.TorrentInfo) {
+func printTable(w io.Writer, outputType string, input []*qbClient.TorrentInfo) error {
 	t := table.NewWriter()
-	t.SetOutputMirror(os.Stdout)
-	t.AppendHeader(table.Row{"Name", "Host", "Category", "Ratio", "Path"})
+	t.AppendHeader(table.Row{"Name", "Server", "Category", "Ratio", "Path"})
 
 	t.SortBy([]table.SortBy{
-		{Name: "Host", Mode: table.Asc},
+		{Name: "Server", Mode: table.Asc},
 		{Name: "Name", Mode: table.Asc},
 	})
 
-	var lastHost *url.URL
+	lastServer := ""
 
 	if len(input) > 0 {
-		lastHost = input[0].Client.BasePath
-	} else {
-		lastHost, _ = url.Parse("https://google.com/") //dummy url so we can print a blank table.
+		lastServer = input[0].Client.Name
 	}
 
 	for _, i := range input {
-		if lastHost != i.Client.BasePath {
+		if lastServer != i.Client.Name {
 			t.AppendSeparator()
-			lastHost = i.Client.BasePath
+			lastServer = i.Client.Name
 		}
 		t.AppendRow(table.Row{
 			i.Name,
-			i.Client.BasePath,
+			i.Client.Name,
 			i.Category,
 			fmt.Sprintf("%.2f", i.Ratio),
 			i.ContentPath,
@@ -61,15 +110,6 @@ func printTable(outputType string, input []*qbClient.TorrentInfo) {
 
 	}
 
-	switch outputType {
-	default:
-		t.Render()
-	case "csv":
-		t.RenderCSV()
-	case "tsv":
-		t.RenderTSV()
-	case "markdown":
-		t.RenderMarkdown()
-	}
+	return writeTable(w, outputType, t)
 
 }
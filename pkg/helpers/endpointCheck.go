@@ -0,0 +1,60 @@
+package helpers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+// EndpointCheck is the result of connecting to a single endpoint. Error is empty when the check passed.
+type EndpointCheck struct {
+	Server  string `json:"server"`
+	Path    string `json:"path"`
+	Version string `json:"version,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// CheckEndpoints logs in to every endpoint, detects its WebAPI version and makes an authenticated request.
+// The endpoints are checked at the same time, each one given up to timeout. Results are in the order of endpoints.
+func CheckEndpoints(ctx context.Context, endpoints []configuration.QbLogin, timeout time.Duration) []EndpointCheck {
+	rtnMe := make([]EndpointCheck, len(endpoints))
+
+	var wg sync.WaitGroup
+	for i, endpoint := range endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rtnMe[i] = checkEndpoint(ctx, endpoint, timeout)
+		}()
+	}
+	wg.Wait()
+
+	return rtnMe
+}
+
+func checkEndpoint(ctx context.Context, endpoint configuration.QbLogin, timeout time.Duration) EndpointCheck {
+	rtnMe := EndpointCheck{
+		Server: endpoint.GetName(),
+		Path:   endpoint.Path,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := qbClient.Login(ctx, endpoint)
+	if err != nil {
+		rtnMe.Error = err.Error()
+		return rtnMe
+	}
+
+	err = client.Validate(ctx)
+	rtnMe.Version = client.Version()
+	if err != nil {
+		rtnMe.Error = err.Error()
+	}
+
+	return rtnMe
+}
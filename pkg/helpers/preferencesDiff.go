@@ -0,0 +1,85 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+// PreferenceDiff is a single preference on a server that doesn't match the baseline.
+// Actual is nil when the server doesn't report the key at all.
+type PreferenceDiff struct {
+	Server   string `json:"server"`
+	Key      string `json:"key"`
+	Expected any    `json:"expected"`
+	Actual   any    `json:"actual"`
+}
+
+// DiffPreferences compares the preferences of every client against baseline and returns the keys that differ,
+// sorted by server and key.
+func DiffPreferences(ctx context.Context, clients []*qbClient.Client, baseline map[string]any) ([]PreferenceDiff, error) {
+	rtnMe := make([]PreferenceDiff, 0)
+
+	for _, client := range clients {
+		prefs, err := client.GetPreferences(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		actual, err := prefs.Map()
+		if err != nil {
+			return nil, err
+		}
+
+		for key, expected := range baseline {
+			expectedNormalized, errL := normalizeJson(expected)
+			if errL != nil {
+				return nil, errL
+			}
+
+			var actualNormalized any
+			if raw, exist := actual[key]; exist {
+				errL = json.Unmarshal(raw, &actualNormalized)
+				if errL != nil {
+					return nil, errL
+				}
+			}
+
+			if reflect.DeepEqual(expectedNormalized, actualNormalized) {
+				continue
+			}
+
+			rtnMe = append(rtnMe, PreferenceDiff{
+				Server:   client.Name,
+				Key:      key,
+				Expected: expectedNormalized,
+				Actual:   actualNormalized,
+			})
+		}
+	}
+
+	slices.SortFunc(rtnMe, func(a, b PreferenceDiff) int {
+		if c := strings.Compare(a.Server, b.Server); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return rtnMe, nil
+}
+
+// normalizeJson round trips v through JSON so values from YAML compare equal to values from the WebAPI.
+// Ex. the YAML int 5 and the JSON number 5 both become float64(5).
+func normalizeJson(v any) (any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var rtnMe any
+	err = json.Unmarshal(b, &rtnMe)
+	return rtnMe, err
+}
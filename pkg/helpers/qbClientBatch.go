@@ -28,7 +28,7 @@ func GetAllCategories(ctx context.Context) (map[string]*qbClient.Category, error
 				}
 				curr = categories[v.Name]
 			}
-			curr.Servers = append(curr.Servers, client.BasePath.String())
+			curr.Servers = append(curr.Servers, client.Name)
 		}
 	}
 
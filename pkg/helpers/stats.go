@@ -0,0 +1,205 @@
+package helpers
+
+import (
+	"cmp"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+// TorrentStats adds up a set of torrents. Sizes are bytes.
+type TorrentStats struct {
+	Count        int     `json:"count"`
+	Size         int64   `json:"sizeBytes"`
+	Uploaded     int64   `json:"uploadedBytes"`
+	Downloaded   int64   `json:"downloadedBytes"`
+	AverageRatio float64 `json:"averageRatio"`
+
+	ratioSum float64
+}
+
+func (s *TorrentStats) add(torrent *qbClient.TorrentInfo) {
+	s.Count++
+	s.Size += torrent.Size
+	s.Uploaded += torrent.Uploaded
+	s.Downloaded += torrent.Downloaded
+	s.ratioSum += torrent.Ratio
+	s.AverageRatio = s.ratioSum / float64(s.Count)
+}
+
+// StatsGroup is the stats of the torrents sharing Key, ex. the category.
+type StatsGroup struct {
+	Key string `json:"key"`
+	TorrentStats
+}
+
+const (
+	GroupByServer   = "server"
+	GroupByCategory = "category"
+	GroupByTag      = "tag"
+	// GroupByTracker groups by the host name of the current tracker. Ex. tracker.example.org
+	GroupByTracker = "tracker"
+	GroupByState   = "state"
+)
+
+var groupKeys = map[string]func(torrent *qbClient.TorrentInfo) []string{
+	GroupByServer:   func(t *qbClient.TorrentInfo) []string { return []string{t.Client.Name} },
+	GroupByCategory: func(t *qbClient.TorrentInfo) []string { return []string{t.Category} },
+	GroupByState:    func(t *qbClient.TorrentInfo) []string { return []string{t.State} },
+	GroupByTracker:  func(t *qbClient.TorrentInfo) []string { return []string{TrackerDomain(t.Tracker)} },
+	// A torrent with several tags is in the group of each of them.
+	GroupByTag: func(t *qbClient.TorrentInfo) []string {
+		tags := t.TagList()
+		if len(tags) == 0 {
+			return []string{""}
+		}
+		return tags
+	},
+}
+
+// GroupStats returns the stats of every torrent and of each group, sorted by key. Torrents without a
+// category, tag or tracker are in the group with an empty key. groupBy is one of the GroupBy constants,
+// or "" for no groups.
+func GroupStats(torrents []*qbClient.TorrentInfo, groupBy string) (TorrentStats, []StatsGroup, error) {
+	var total TorrentStats
+	for _, torrent := range torrents {
+		total.add(torrent)
+	}
+
+	if groupBy == "" {
+		return total, make([]StatsGroup, 0), nil
+	}
+
+	keysOf, exist := groupKeys[groupBy]
+	if !exist {
+		return TorrentStats{}, nil, fmt.Errorf("can't group by %s, use server, category, tag, tracker or state", groupBy)
+	}
+
+	groups := make(map[string]*StatsGroup)
+	for _, torrent := range torrents {
+		for _, key := range keysOf(torrent) {
+			group, exist := groups[key]
+			if !exist {
+				group = &StatsGroup{Key: key}
+				groups[key] = group
+			}
+			group.add(torrent)
+		}
+	}
+
+	rtnMe := make([]StatsGroup, 0, len(groups))
+	for _, group := range groups {
+		rtnMe = append(rtnMe, *group)
+	}
+	slices.SortFunc(rtnMe, func(a, b StatsGroup) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return total, rtnMe, nil
+}
+
+// TrackerDomain returns the host name of a tracker url, or "" if there is none.
+func TrackerDomain(tracker string) string {
+	u, err := url.Parse(tracker)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Hostname())
+}
+
+// HistogramBucket counts the torrents from Min, inclusive, to Max. The first bucket has no Min and the last no Max.
+type HistogramBucket struct {
+	Label string   `json:"label"`
+	Min   *float64 `json:"min"`
+	Max   *float64 `json:"max"`
+	Count int      `json:"count"`
+	Size  int64    `json:"sizeBytes"`
+}
+
+type histogramField struct {
+	value  func(torrent *qbClient.TorrentInfo, now time.Time) float64
+	bounds []float64
+	unit   string
+}
+
+const (
+	HistogramRatio = "ratio"
+	// HistogramAge is in days since the torrent was added.
+	HistogramAge = "age"
+	// HistogramSize is in GiB.
+	HistogramSize = "size"
+)
+
+var histogramFields = map[string]histogramField{
+	HistogramRatio: {
+		value:  func(t *qbClient.TorrentInfo, _ time.Time) float64 { return t.Ratio },
+		bounds: []float64{0.5, 1, 2, 5},
+	},
+	HistogramAge: {
+		value: func(t *qbClient.TorrentInfo, now time.Time) float64 {
+			return now.Sub(t.AddedOn.Time()).Hours() / 24
+		},
+		bounds: []float64{1, 7, 30, 90, 365},
+		unit:   "d",
+	},
+	HistogramSize: {
+		value:  func(t *qbClient.TorrentInfo, _ time.Time) float64 { return float64(t.Size) / (1 << 30) },
+		bounds: []float64{1, 10, 50, 100},
+		unit:   "GiB",
+	},
+}
+
+// Histogram counts torrents in buckets split at bounds, which default to a set per field when empty.
+// field is one of the Histogram constants.
+func Histogram(torrents []*qbClient.TorrentInfo, field string, bounds []float64, now time.Time) ([]HistogramBucket, error) {
+	f, exist := histogramFields[field]
+	if !exist {
+		return nil, fmt.Errorf("no histogram of %s, use ratio, age or size", field)
+	}
+	if len(bounds) == 0 {
+		bounds = f.bounds
+	}
+	bounds = slices.Sorted(slices.Values(bounds))
+	bounds = slices.Compact(bounds)
+
+	rtnMe := make([]HistogramBucket, len(bounds)+1)
+	for i := range rtnMe {
+		bucket := &rtnMe[i]
+		if i > 0 {
+			bucket.Min = &bounds[i-1]
+		}
+		if i < len(bounds) {
+			bucket.Max = &bounds[i]
+		}
+
+		switch {
+		case bucket.Min == nil:
+			bucket.Label = "< " + formatBound(*bucket.Max, f.unit)
+		case bucket.Max == nil:
+			bucket.Label = ">= " + formatBound(*bucket.Min, f.unit)
+		default:
+			bucket.Label = formatBound(*bucket.Min, f.unit) + " - " + formatBound(*bucket.Max, f.unit)
+		}
+	}
+
+	for _, torrent := range torrents {
+		// The bucket is the number of bounds at or below the value.
+		index, found := slices.BinarySearchFunc(bounds, f.value(torrent, now), cmp.Compare[float64])
+		if found {
+			index++
+		}
+		rtnMe[index].Count++
+		rtnMe[index].Size += torrent.Size
+	}
+
+	return rtnMe, nil
+}
+
+func formatBound(bound float64, unit string) string {
+	return strconv.FormatFloat(bound, 'f', -1, 64) + unit
+}
@@ -0,0 +1,32 @@
+package helpers
+
+import (
+	"strings"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+var fileNameReplacer = strings.NewReplacer(
+	"/", "_", "\\", "_", ":", "_", "*", "_", "?", "_", "\"", "_", "<", "_", ">", "_", "|", "_",
+)
+
+// TorrentFileName returns a file name that is safe on every OS for the torrent's .torrent file.
+// The start of the hash is added so torrents with the same name don't overwrite each other.
+func TorrentFileName(torrent *qbClient.TorrentInfo) string {
+	hash := torrent.Hash
+	if len(hash) > 8 {
+		hash = hash[:8]
+	}
+
+	name := SafeFileName(torrent.Name)
+	if name == "" {
+		return hash + ".torrent"
+	}
+
+	return name + "-" + hash + ".torrent"
+}
+
+// SafeFileName replaces the characters that aren't allowed in file names on Windows or Linux.
+func SafeFileName(name string) string {
+	return strings.TrimSpace(fileNameReplacer.Replace(name))
+}
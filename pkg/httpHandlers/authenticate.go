@@ -0,0 +1,61 @@
+package httpHandlers
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/auth"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+	"github.com/labstack/echo/v5"
+)
+
+// Authenticate adds the request's session to its context as an *auth.Request, and rejects requests that change
+// something without the session's CSRF token. With required, requests without a session are rejected too.
+// failures is where the login mutation counts failed logins. It does nothing while the config has no users.
+func Authenticate(sessions *auth.Sessions, failures *auth.LoginFailures, required bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c *echo.Context) error {
+			config := configuration.MustGetConfig()
+			if !config.Auth.Enabled() {
+				return next(c)
+			}
+
+			req := &auth.Request{
+				Writer:   c.Response(),
+				Request:  c.Request(),
+				Sessions: sessions,
+				Failures: failures,
+			}
+
+			if cookie, err := c.Cookie(auth.SessionCookie); err == nil {
+				session, exist := sessions.Get(cookie.Value)
+				// A user removed from the config is logged out.
+				if _, userExist := config.Auth.User(session.User); exist && !userExist {
+					sessions.Delete(session.Token)
+					exist = false
+				}
+				if exist {
+					req.Session = &session
+				}
+			}
+
+			if req.Session != nil && !safeMethod(c.Request().Method) {
+				token := c.Request().Header.Get(auth.CSRFHeader)
+				if subtle.ConstantTimeCompare([]byte(token), []byte(req.Session.CSRFToken)) != 1 {
+					return echo.NewHTTPError(http.StatusForbidden, "missing or invalid CSRF token")
+				}
+			}
+
+			if req.Session == nil && required {
+				return echo.NewHTTPError(http.StatusUnauthorized, "log in first")
+			}
+
+			c.SetRequest(c.Request().WithContext(auth.WithRequest(c.Request().Context(), req)))
+			return next(c)
+		}
+	}
+}
+
+func safeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
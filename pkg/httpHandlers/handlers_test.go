@@ -0,0 +1,188 @@
+package httpHandlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/httpHandlers"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbFake"
+	"github.com/labstack/echo/v5"
+)
+
+var (
+	up   *qbFake.Server
+	down *qbFake.Server
+)
+
+// TestMain points the registry at a working fake server and one that fails every request.
+func TestMain(m *testing.M) {
+	up = qbFake.New(qbFake.WithTorrents(
+		qbFake.Torrent{Name: "debian:13.iso", Category: "linux"},
+	))
+	down = qbFake.New()
+
+	qbFake.Main(m, qbFake.TestConfig{
+		Endpoints: []configuration.QbLogin{up.Login("up"), down.Login("down")},
+		Setup: func(string) error {
+			// Log in before the fault, the registry skips servers it can't reach.
+			qbClient.Registry()
+			down.InjectFault("", qbFake.Fault{Status: http.StatusInternalServerError})
+			return nil
+		},
+	}, up, down)
+}
+
+func newEcho() *echo.Echo {
+	e := echo.New()
+	e.GET("/health", httpHandlers.HealthCheck)
+	e.GET("/ready", httpHandlers.ReadyCheck)
+	e.GET("/health/servers", httpHandlers.HealthDetails)
+	e.POST("/uploadTorrent", httpHandlers.TorrentUpload)
+	e.GET("/exportTorrent", httpHandlers.TorrentExport)
+	return e
+}
+
+func serve(req *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	newEcho().ServeHTTP(rec, req)
+	return rec
+}
+
+// probeAll probes every server enough times for a failing one to be marked down.
+func probeAll() {
+	settings := configuration.MustGetConfig().Health
+	for range settings.DownAfter {
+		for _, client := range qbClient.Registry().All() {
+			client.Probe(context.Background(), settings)
+		}
+	}
+}
+
+func TestHealthAndReady(t *testing.T) {
+	probeAll()
+
+	var health struct {
+		Status  string
+		Healthy int
+		Total   int
+		Servers []struct {
+			Server string
+			Status qbClient.HealthStatus
+		}
+	}
+
+	rec := serve(httptest.NewRequest(http.MethodGet, "/health", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("/health: got %d", rec.Code)
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &health); err != nil {
+		t.Fatal(err)
+	}
+	if health.Status != "ok" || health.Healthy != 1 || health.Total != 2 {
+		t.Errorf("/health: got %+v", health)
+	}
+	// It's public, server names and errors with their URLs stay out of it.
+	if health.Servers != nil || strings.Contains(rec.Body.String(), down.URL) {
+		t.Errorf("/health: got servers %s", rec.Body)
+	}
+
+	rec = serve(httptest.NewRequest(http.MethodGet, "/health/servers", nil))
+	if err := json.Unmarshal(rec.Body.Bytes(), &health); err != nil {
+		t.Fatal(err)
+	}
+	if len(health.Servers) != 2 || health.Servers[0].Status != qbClient.HealthDown || health.Servers[1].Status != qbClient.HealthUp {
+		t.Errorf("/health/servers: got servers %+v", health.Servers)
+	}
+
+	// The default health.minHealthy is 1, so one working server is enough.
+	rec = serve(httptest.NewRequest(http.MethodGet, "/ready", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("/ready: got %d, body %s", rec.Code, rec.Body)
+	}
+}
+
+func TestTorrentExport(t *testing.T) {
+	torrent := up.Torrents()[0]
+
+	rec := serve(httptest.NewRequest(http.MethodGet, "/exportTorrent?server=up&hash="+torrent.Hash, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got %d, body %s", rec.Code, rec.Body)
+	}
+	if !bytes.Equal(rec.Body.Bytes(), torrent.Content) {
+		t.Errorf("got body %q, want %q", rec.Body, torrent.Content)
+	}
+
+	// Names outside ASCII are encoded the way browsers decode them.
+	up.AddTorrent(qbFake.Torrent{Name: "Fédora 42.iso"})
+	fedora := up.Torrents()[len(up.Torrents())-1]
+
+	for name, hash := range map[string]string{"debian_13.iso": torrent.Hash, "Fédora 42.iso": fedora.Hash} {
+		rec = serve(httptest.NewRequest(http.MethodGet, "/exportTorrent?server=up&hash="+hash, nil))
+		want := name + "-" + hash[:8] + ".torrent"
+		disposition, params, err := mime.ParseMediaType(rec.Header().Get(echo.HeaderContentDisposition))
+		if err != nil || disposition != "attachment" || params["filename"] != want {
+			t.Errorf("Content-Disposition = %s, want an attachment called %s", rec.Header().Get(echo.HeaderContentDisposition), want)
+		}
+	}
+
+	tests := []struct {
+		query string
+		want  int
+	}{
+		{"server=up", http.StatusBadRequest},
+		{"server=nope&hash=" + torrent.Hash, http.StatusNotFound},
+		{"server=up&hash=0000", http.StatusNotFound},
+	}
+	for _, test := range tests {
+		rec = serve(httptest.NewRequest(http.MethodGet, "/exportTorrent?"+test.query, nil))
+		if rec.Code != test.want {
+			t.Errorf("%s: got %d, want %d", test.query, rec.Code, test.want)
+		}
+	}
+}
+
+func TestTorrentUpload(t *testing.T) {
+	upload := func(category string) *httptest.ResponseRecorder {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		_ = writer.WriteField("category", category)
+		part, _ := writer.CreateFormFile("torrents", "uploaded.torrent")
+		_, _ = part.Write([]byte("d4:infod4:name8:uploadedee"))
+		_ = writer.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/uploadTorrent", body)
+		req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+		return serve(req)
+	}
+
+	// Categories are read from every server, so the failing one has to come back first.
+	down.ClearFaults()
+	defer down.InjectFault("", qbFake.Fault{Status: http.StatusInternalServerError})
+
+	if rec := upload("linux"); rec.Code != http.StatusOK {
+		t.Fatalf("got %d, body %s", rec.Code, rec.Body)
+	}
+
+	found := false
+	for _, torrent := range up.Torrents() {
+		if torrent.Name == "uploaded" && torrent.Category == "linux" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("uploaded torrent not added to the server with the category")
+	}
+
+	if rec := upload("missing"); rec.Code != http.StatusNotFound {
+		t.Errorf("unknown category: got %d, want 404", rec.Code)
+	}
+}
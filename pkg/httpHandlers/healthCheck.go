@@ -1,29 +1,117 @@
 package httpHandlers
 
 import (
-	"log/slog"
 	"net/http"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
 	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
 	"github.com/labstack/echo/v5"
 )
 
+type serverHealth struct {
+	Server              string                `json:"server"`
+	Status              qbClient.HealthStatus `json:"status"`
+	LastCheck           *time.Time            `json:"lastCheck"`
+	LastSuccess         *time.Time            `json:"lastSuccess"`
+	LastError           string                `json:"lastError,omitempty"`
+	LatencyMs           int64                 `json:"latencyMs"`
+	ConsecutiveFailures int                   `json:"consecutiveFailures"`
+}
+
+type healthResponse struct {
+	Status   string         `json:"status"`
+	Healthy  int            `json:"healthy"`
+	Required int            `json:"required"`
+	Total    int            `json:"total"`
+	Servers  []serverHealth `json:"servers,omitempty"`
+}
+
+// HealthCheck is the liveness probe, it passes as long as the panel is running.
+// The body only has counts, it's served without a login. See HealthDetails for every server.
 func HealthCheck(c *echo.Context) error {
+	rtnMe, err := cachedHealth(false)
+	if err != nil {
+		return err
+	}
+	rtnMe.Status = "ok"
+
+	return c.JSON(http.StatusOK, rtnMe)
+}
+
+// ReadyCheck is the readiness probe, it fails with 503 until enough servers are healthy, see health.minHealthy.
+func ReadyCheck(c *echo.Context) error {
+	rtnMe, err := cachedHealth(false)
+	if err != nil {
+		return err
+	}
+
+	if rtnMe.Total == 0 || rtnMe.Healthy < rtnMe.Required {
+		rtnMe.Status = "not ready"
+		return c.JSON(http.StatusServiceUnavailable, rtnMe)
+	}
+
+	rtnMe.Status = "ready"
+	return c.JSON(http.StatusOK, rtnMe)
+}
+
+// HealthDetails is HealthCheck with the cached state of every server, including the last error, which can have
+// a server's URL in it.
+func HealthDetails(c *echo.Context) error {
+	rtnMe, err := cachedHealth(true)
+	if err != nil {
+		return err
+	}
+	rtnMe.Status = "ok"
+
+	return c.JSON(http.StatusOK, rtnMe)
+}
+
+// cachedHealth counts the healthy servers, nothing is sent to them. With details it lists every server too.
+func cachedHealth(details bool) (healthResponse, error) {
 	clients := qbClient.Registry().All()
+	slices.SortFunc(clients, func(a, b *qbClient.Client) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	required, err := configuration.MustGetConfig().Health.RequiredHealthy(len(clients))
+	if err != nil {
+		return healthResponse{}, err
+	}
 
-	if len(clients) == 0 {
-		return echo.NewHTTPError(http.StatusServiceUnavailable, "no qbClient found")
+	rtnMe := healthResponse{
+		Required: required,
+		Total:    len(clients),
 	}
 
 	for _, client := range clients {
-		_, err := client.GetVersion(c.Request().Context())
-		if err != nil {
-			slog.Error("unable to connect to qbittorrent", "error", err, "url", client.BasePath.String())
-			return err
+		health := client.Health()
+		if health.Healthy() {
+			rtnMe.Healthy++
 		}
+		if !details {
+			continue
+		}
+
+		rtnMe.Servers = append(rtnMe.Servers, serverHealth{
+			Server:              client.Name,
+			Status:              health.Status,
+			LastCheck:           timeOrNil(health.LastCheck),
+			LastSuccess:         timeOrNil(health.LastSuccess),
+			LastError:           health.LastError,
+			LatencyMs:           health.Latency.Milliseconds(),
+			ConsecutiveFailures: health.ConsecutiveFailures,
+		})
 	}
 
-	return c.JSON(http.StatusOK, map[string]string{
-		"status": "ok",
-	})
+	return rtnMe, nil
+}
+
+func timeOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
 }
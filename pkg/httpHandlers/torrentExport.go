@@ -0,0 +1,47 @@
+package httpHandlers
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/helpers"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+	"github.com/labstack/echo/v5"
+)
+
+func TorrentExport(c *echo.Context) error {
+	ctx := c.Request().Context()
+
+	server := c.QueryParam("server")
+	hash := c.QueryParam("hash")
+	if server == "" || hash == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "server and hash are required")
+	}
+
+	client, exist := qbClient.Registry().Get(server)
+	if !exist {
+		return echo.NewHTTPError(http.StatusNotFound, "server not found")
+	}
+
+	filename := hash + ".torrent"
+	torrent, err := client.GetTorrent(ctx, hash)
+	if err == nil {
+		filename = helpers.TorrentFileName(torrent)
+	} else if !errors.Is(err, qbClient.ErrNotFound) {
+		return err
+	}
+
+	file, err := client.ExportTorrent(ctx, hash)
+	if err != nil {
+		if errors.Is(err, qbClient.ErrNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "torrent not found")
+		}
+		return err
+	}
+	defer file.Close()
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+
+	return c.Stream(http.StatusOK, "application/x-bittorrent", file)
+}
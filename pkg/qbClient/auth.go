@@ -0,0 +1,64 @@
+package qbClient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func (c *Client) usesPassword() bool {
+	return c.login.Username != ""
+}
+
+// ensureSession logs in with the username and password unless the client already has a session.
+// The session cookie is kept by the http client's cookie jar.
+func (c *Client) ensureSession(ctx context.Context) error {
+	c.sessionMu.Lock()
+	defer c.sessionMu.Unlock()
+
+	if c.loggedIn {
+		return nil
+	}
+
+	form := url.Values{
+		"username": {c.login.Username},
+		"password": {c.login.Password.Value()},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BasePath.JoinPath("/api/v2/auth/login").String(),
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=UTF-8")
+	// qBittorrent rejects logins whose Referer doesn't match the host when CSRF protection is on.
+	req.Header.Set("Referer", c.BasePath.String())
+
+	resp, err := c.send(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// A wrong password is still a 200, only the body tells them apart.
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(string(body)) != "Ok." {
+		return fmt.Errorf("%s: login as %s: %w", c.BasePath.String(), c.login.Username, ErrUnauthorized)
+	}
+
+	c.loggedIn = true
+	return nil
+}
+
+func (c *Client) endSession() {
+	c.sessionMu.Lock()
+	defer c.sessionMu.Unlock()
+
+	c.loggedIn = false
+}
@@ -0,0 +1,122 @@
+package qbClient
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Capability is a WebAPI feature that isn't available on every qBittorrent version.
+type Capability string
+
+const (
+	// CapabilityStopStart means torrents are paused with /torrents/stop and /torrents/start. Older servers use pause and resume.
+	CapabilityStopStart Capability = "stopStart"
+	// CapabilityExport means .torrent files can be downloaded with /torrents/export.
+	CapabilityExport Capability = "export"
+)
+
+// capabilityVersions is the first WebAPI version that has each capability.
+var capabilityVersions = map[Capability]apiVersion{
+	CapabilityStopStart: {2, 11, 0},
+	CapabilityExport:    {2, 8, 14},
+}
+
+type apiVersion [3]int
+
+// parseApiVersion parses a WebAPI version. Ex. 2.11.2, missing parts are 0.
+func parseApiVersion(version string) (apiVersion, error) {
+	var rtnMe apiVersion
+
+	parts := strings.Split(strings.TrimPrefix(strings.TrimSpace(version), "v"), ".")
+	if len(parts) > len(rtnMe) {
+		return rtnMe, fmt.Errorf("invalid WebAPI version %q", version)
+	}
+
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return rtnMe, fmt.Errorf("invalid WebAPI version %q", version)
+		}
+		rtnMe[i] = n
+	}
+
+	return rtnMe, nil
+}
+
+func (v apiVersion) atLeast(other apiVersion) bool {
+	return slices.Compare(v[:], other[:]) >= 0
+}
+
+// DetectVersion asks the server for its WebAPI version and works out its capabilities.
+// It's called when the client is registered, methods that depend on a capability call it again if that failed.
+func (c *Client) DetectVersion(ctx context.Context) error {
+	raw, err := c.GetVersion(ctx)
+	if err != nil {
+		return err
+	}
+
+	version, err := parseApiVersion(raw)
+	if err != nil {
+		return err
+	}
+
+	capabilities := make(map[Capability]bool, len(capabilityVersions))
+	for capability, since := range capabilityVersions {
+		capabilities[capability] = version.atLeast(since)
+	}
+
+	c.versionMu.Lock()
+	defer c.versionMu.Unlock()
+	c.version = raw
+	c.capabilities = capabilities
+
+	return nil
+}
+
+// Version returns the WebAPI version, empty if it hasn't been detected yet.
+func (c *Client) Version() string {
+	c.versionMu.RLock()
+	defer c.versionMu.RUnlock()
+
+	return c.version
+}
+
+// Capabilities returns the capabilities of the server sorted by name, nil if the version hasn't been detected yet.
+func (c *Client) Capabilities() []Capability {
+	c.versionMu.RLock()
+	defer c.versionMu.RUnlock()
+
+	var rtnMe []Capability
+	for capability, supported := range c.capabilities {
+		if supported {
+			rtnMe = append(rtnMe, capability)
+		}
+	}
+	slices.Sort(rtnMe)
+
+	return rtnMe
+}
+
+// HasCapability reports whether the server supports capability, detecting the version first if needed.
+func (c *Client) HasCapability(ctx context.Context, capability Capability) (bool, error) {
+	c.versionMu.RLock()
+	supported, detected := c.capabilities[capability]
+	c.versionMu.RUnlock()
+
+	if detected {
+		return supported, nil
+	}
+
+	err := c.DetectVersion(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	c.versionMu.RLock()
+	defer c.versionMu.RUnlock()
+
+	return c.capabilities[capability], nil
+}
@@ -15,23 +15,39 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
 )
 
-var httpClient http.Client
-
 type Client struct {
+	// Name is the stable, display name of the server. It's the key in the registry.
+	Name     string
+	Group    string
+	Labels   map[string]string
 	BasePath *url.URL
 	apiKey   string
+	headers  map[string]string
+	login    configuration.QbLogin
+
+	httpClient *http.Client
+	transport  transportSettings
+	breaker    *circuitBreaker
+
+	// sessionMu guards loggedIn, only used when logging in with a username and password.
+	sessionMu sync.Mutex
+	loggedIn  bool
+
+	clientHealth
+
+	versionMu    sync.RWMutex
+	version      string
+	capabilities map[Capability]bool
 }
 
-// MarshalJSON customizes the JSON output to show only the base path string
+// MarshalJSON customizes the JSON output to show only the server name
 func (c *Client) MarshalJSON() ([]byte, error) {
-	if c.BasePath == nil {
-		return json.Marshal(nil)
-	}
-	return json.Marshal(c.BasePath.String())
+	return json.Marshal(c.Name)
 }
 
 // Login authenticates the user and returns a Client object.
@@ -41,37 +57,63 @@ func Login(ctx context.Context, login configuration.QbLogin) (*Client, error) {
 		return nil, err
 	}
 
+	transport, err := newTransportSettings(login.Transport)
+	if err != nil {
+		return nil, err
+	}
+
 	rtnMe := &Client{
-		BasePath: baseUrl,
-		apiKey:   login.ApiKey,
+		Name:       login.GetName(),
+		Group:      login.Group,
+		Labels:     login.Labels,
+		BasePath:   baseUrl,
+		apiKey:     login.ApiKey.Value(),
+		headers:    login.Headers,
+		login:      login,
+		httpClient: newHttpClient(transport, login.Username != ""),
+		transport:  transport,
+		breaker:    newCircuitBreaker(transport.breakerThreshold, transport.breakerCooldown),
 	}
 
 	return rtnMe, err
 }
 
-func (c *Client) attachAuthHeader(req *http.Request) {
-	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+// Config returns the endpoint configuration the client was created from.
+func (c *Client) Config() configuration.QbLogin {
+	return c.login
 }
 
-func (c *Client) GetTorrents(ctx context.Context) ([]*TorrentInfo, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", c.BasePath.String()+"/api/v2/torrents/info?sort=added_on", nil)
+// Validate checks the server can be reached and accepts the credentials.
+func (c *Client) Validate(ctx context.Context) error {
+	err := c.DetectVersion(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	c.attachAuthHeader(req)
+	_, err = c.GetCategories(ctx)
+	return err
+}
 
-	resp, err := httpClient.Do(req)
-	if err != nil {
-		return nil, err
+// Available reports whether requests are being sent to the server, false while its circuit breaker is open.
+func (c *Client) Available() bool {
+	return !c.breaker.open()
+}
+
+func (c *Client) attachAuthHeader(req *http.Request) {
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
 	}
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+}
 
-	body, _ := io.ReadAll(resp.Body)
-	defer resp.Body.Close()
+func (c *Client) GetTorrents(ctx context.Context) ([]*TorrentInfo, error) {
+	data := url.Values{}
+	data.Set("sort", "added_on")
 
 	var rtnMe []*TorrentInfo
-
-	err = json.Unmarshal(body, &rtnMe)
+	err := c.getJSON(ctx, "/api/v2/torrents/info", data, &rtnMe)
 	if err != nil {
 		return nil, err
 	}
@@ -82,33 +124,31 @@ func (c *Client) GetTorrents(ctx context.Context) ([]*TorrentInfo, error) {
 	return rtnMe, nil
 }
 
-var TorrentNotFoundError = errors.New("torrent not found")
-
-func (c *Client) GetTorrent(ctx context.Context, infoHash string) (*TorrentInfo, error) {
-	// api/v2/torrents/info?hashs={{hash}}
-
+// GetTorrentsByHash returns the torrents with hashes, hashes the server doesn't have are left out.
+func (c *Client) GetTorrentsByHash(ctx context.Context, hashes []string) ([]*TorrentInfo, error) {
 	data := url.Values{}
-	data.Set("hashes", infoHash)
-
-	currUrl := c.BasePath.JoinPath("/api/v2/torrents/info")
-	currUrl.RawQuery = data.Encode()
+	data.Set("hashes", strings.Join(hashes, "|"))
 
-	req, err := http.NewRequestWithContext(ctx, "GET", currUrl.String(), nil)
+	var rtnMe []*TorrentInfo
+	err := c.getJSON(ctx, "/api/v2/torrents/info", data, &rtnMe)
 	if err != nil {
 		return nil, err
 	}
-	c.attachAuthHeader(req)
-
-	resp, err := httpClient.Do(req)
-	if err != nil {
-		return nil, err
+	for _, v := range rtnMe {
+		v.Client = c
 	}
 
-	body, _ := io.ReadAll(resp.Body)
-	defer resp.Body.Close()
+	return rtnMe, nil
+}
+
+func (c *Client) GetTorrent(ctx context.Context, infoHash string) (*TorrentInfo, error) {
+	// api/v2/torrents/info?hashs={{hash}}
+
+	data := url.Values{}
+	data.Set("hashes", infoHash)
 
 	var rtnMe []*TorrentInfo
-	err = json.Unmarshal(body, &rtnMe)
+	err := c.getJSON(ctx, "/api/v2/torrents/info", data, &rtnMe)
 	if err != nil {
 		return nil, err
 	}
@@ -116,6 +156,7 @@ func (c *Client) GetTorrent(ctx context.Context, infoHash string) (*TorrentInfo,
 	if len(rtnMe) != 1 {
 		return nil, TorrentNotFoundError
 	}
+	rtnMe[0].Client = c
 
 	return rtnMe[0], nil
 
@@ -127,25 +168,11 @@ func (c *Client) GetTracker(ctx context.Context, infohash string) ([]*TorrentTra
 	data := url.Values{}
 	data.Set("hash", infohash)
 
-	currUrl := c.BasePath.JoinPath("/api/v2/torrents/trackers")
-	currUrl.RawQuery = data.Encode()
-
-	req, err := http.NewRequestWithContext(ctx, "GET", currUrl.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-	c.attachAuthHeader(req)
-
-	resp, err := httpClient.Do(req)
+	var rtnMe []*TorrentTracker
+	err := c.getJSON(ctx, "/api/v2/torrents/trackers", data, &rtnMe)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	body, _ := io.ReadAll(resp.Body)
-
-	var rtnMe []*TorrentTracker
-	err = json.Unmarshal(body, &rtnMe)
 
 	return rtnMe, nil
 }
@@ -153,29 +180,9 @@ func (c *Client) GetTracker(ctx context.Context, infohash string) ([]*TorrentTra
 // GetCategories list all the categories in a given httpClient.
 // Returns a map of categories where the key is the name of the category, and the value is Category
 func (c *Client) GetCategories(ctx context.Context) (map[string]Category, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", c.BasePath.String()+"/api/v2/torrents/categories", nil)
-	if err != nil {
-		return nil, err
-	}
-
-	c.attachAuthHeader(req)
-
-	resp, err := httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
 	var qbCategories map[string]Category
 
-	err = json.Unmarshal(body, &qbCategories)
+	err := c.getJSON(ctx, "/api/v2/torrents/categories", nil, &qbCategories)
 	if err != nil {
 		return nil, err
 	}
@@ -188,69 +195,36 @@ func (c *Client) GetCategories(ctx context.Context) (map[string]Category, error)
 // https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)#add-new-category
 func (c *Client) CreateCategoryIfNotExist(ctx context.Context, category *Category) error {
 	categoryInClient := slices.ContainsFunc(category.Servers, func(s string) bool {
-		return c.BasePath.String() == s
+		return c.Name == s
 	})
 	if categoryInClient {
 		return nil
 	}
 
-	categoryInClient = false
-
 	data := url.Values{}
 	data.Set("category", category.Name)
 	data.Set("savePath", category.SavePath)
 
 	slog.Debug("Category", "encoded", data.Encode())
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BasePath.JoinPath("/api/v2/torrents/createCategory").String(),
-		strings.NewReader(data.Encode()))
-	if err != nil {
-		return err
-	}
-	c.attachAuthHeader(req)
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	err := c.postForm(ctx, "/api/v2/torrents/createCategory", data)
 
-	resp, err := httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == 400 {
-		return errors.New("category name is empty")
-	} else if resp.StatusCode == 409 {
-		return errors.New("category name is invalid")
-	} else if resp.StatusCode != 200 {
-		body, _ := io.ReadAll(resp.Body)
-		return errors.New(string(body))
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest {
+		return fmt.Errorf("category name is empty: %w", err)
+	} else if errors.Is(err, ErrConflict) {
+		return fmt.Errorf("category name is invalid: %w", err)
 	}
 
-	return nil
+	return err
 }
 
 func (c *Client) GetFilesInTorrent(ctx context.Context, InfoHashV1 string) ([]TorrentFile, error) {
 	data := url.Values{}
 	data.Set("hash", InfoHashV1)
 
-	path := c.BasePath.JoinPath("/api/v2/torrents/files")
-	path.RawQuery = data.Encode()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, path.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	c.attachAuthHeader(req)
-
-	resp, err := httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	body, _ := io.ReadAll(resp.Body)
-	defer resp.Body.Close()
 	var qbFiles []TorrentFile
-	err = json.Unmarshal(body, &qbFiles)
+	err := c.getJSON(ctx, "/api/v2/torrents/files", data, &qbFiles)
 	if err != nil {
 		return nil, err
 	}
@@ -258,68 +232,36 @@ func (c *Client) GetFilesInTorrent(ctx context.Context, InfoHashV1 string) ([]To
 	return qbFiles, nil
 }
 
+// PauseTorrents uses /torrents/stop on qBittorrent 5 and /torrents/pause on older versions.
 func (c *Client) PauseTorrents(ctx context.Context, hashes []string) error {
-
-	hashesString := strings.Join(hashes, "|")
-
-	data := url.Values{}
-	data.Set("hashes", hashesString)
-
-	path := c.BasePath.JoinPath("/api/v2/torrents/stop")
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, path.String(), strings.NewReader(data.Encode()))
+	stopStart, err := c.HasCapability(ctx, CapabilityStopStart)
 	if err != nil {
 		return err
 	}
 
-	c.attachAuthHeader(req)
-
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=UTF-8")
-
-	resp, err := httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
 
-	if resp.StatusCode != 200 {
-		body, _ := io.ReadAll(resp.Body)
-		return errors.New(string(body))
+	if stopStart {
+		return c.postForm(ctx, "/api/v2/torrents/stop", data)
 	}
-
-	return nil
+	return c.postForm(ctx, "/api/v2/torrents/pause", data)
 }
 
+// ResumeTorrents uses /torrents/start on qBittorrent 5 and /torrents/resume on older versions.
 func (c *Client) ResumeTorrents(ctx context.Context, hashes []string) error {
-
-	hashesString := strings.Join(hashes, "|")
-
-	data := url.Values{}
-	data.Set("hashes", hashesString)
-
-	path := c.BasePath.JoinPath("/api/v2/torrents/start")
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, path.String(), strings.NewReader(data.Encode()))
+	stopStart, err := c.HasCapability(ctx, CapabilityStopStart)
 	if err != nil {
 		return err
 	}
 
-	c.attachAuthHeader(req)
-
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=UTF-8")
-
-	resp, err := httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
 
-	if resp.StatusCode != 200 {
-		body, _ := io.ReadAll(resp.Body)
-		return errors.New(string(body))
+	if stopStart {
+		return c.postForm(ctx, "/api/v2/torrents/start", data)
 	}
-
-	return nil
+	return c.postForm(ctx, "/api/v2/torrents/resume", data)
 }
 
 // UploadTorrentFiles https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)#add-new-torrent
@@ -345,7 +287,7 @@ func (c *Client) UploadTorrentFiles(ctx context.Context, files []UploadTorrentIn
 	}
 
 	categoryHeader := make(textproto.MIMEHeader)
-	categoryHeader.Set("Content-Disposition", fmt.Sprintf(`form-data; name="category"`))
+	categoryHeader.Set("Content-Disposition", `form-data; name="category"`)
 
 	if category != "" {
 		categoryWriter, err := multipartWriter.CreatePart(categoryHeader)
@@ -359,12 +301,15 @@ func (c *Client) UploadTorrentFiles(ctx context.Context, files []UploadTorrentIn
 	}
 
 	autoTmmHeader := make(textproto.MIMEHeader)
-	autoTmmHeader.Set("Content-Disposition", fmt.Sprintf(`form-data; name="autoTMM"`))
+	autoTmmHeader.Set("Content-Disposition", `form-data; name="autoTMM"`)
 	autoTmmWriter, err := multipartWriter.CreatePart(autoTmmHeader)
 	if err != nil {
 		return nil, err
 	}
 	_, err = autoTmmWriter.Write([]byte("true"))
+	if err != nil {
+		return nil, err
+	}
 
 	multipartWriter.Close()
 
@@ -374,71 +319,215 @@ func (c *Client) UploadTorrentFiles(ctx context.Context, files []UploadTorrentIn
 		return nil, err
 	}
 	req.Header.Set("Content-Type", multipartWriter.FormDataContentType())
-	c.attachAuthHeader(req)
 
-	resp, err := httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnsupportedMediaType {
+			return nil, fmt.Errorf("torrent file is invalid: %w", err)
+		}
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == 415 {
-		return nil, errors.New("torrent file is invalid")
-	} else if resp.StatusCode != 200 {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, errors.New(string(body))
-	}
-
 	return nil, nil
 
 }
 
-func (c *Client) DeleteTorrent(ctx context.Context, hashes []string, keepFiles bool) error {
+func (c *Client) DeleteTorrent(ctx context.Context, hashes []string, deleteFiles bool) error {
 	//https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)#delete-torrents
-	hashesString := strings.Join(hashes, "|")
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+	data.Set("deleteFiles", strconv.FormatBool(deleteFiles))
+
+	return c.postForm(ctx, "/api/v2/torrents/delete", data)
+}
+
+// AddTags adds tags to the torrents, creating the tags that don't exist.
+// https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)#add-torrent-tags
+func (c *Client) AddTags(ctx context.Context, hashes []string, tags []string) error {
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+	data.Set("tags", strings.Join(tags, ","))
+
+	return c.postForm(ctx, "/api/v2/torrents/addTags", data)
+}
+
+// RemoveTags removes tags from the torrents, the tags themselves are kept.
+// https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)#remove-torrent-tags
+func (c *Client) RemoveTags(ctx context.Context, hashes []string, tags []string) error {
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+	data.Set("tags", strings.Join(tags, ","))
+
+	return c.postForm(ctx, "/api/v2/torrents/removeTags", data)
+}
+
+// RecheckTorrents checks the downloaded pieces of the torrents again.
+// https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)#recheck-torrents
+func (c *Client) RecheckTorrents(ctx context.Context, hashes []string) error {
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+
+	return c.postForm(ctx, "/api/v2/torrents/recheck", data)
+}
 
+// SetLocation moves the torrents' files to location, a directory on the server.
+// https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)#set-torrent-location
+func (c *Client) SetLocation(ctx context.Context, hashes []string, location string) error {
 	data := url.Values{}
-	data.Set("hashes", hashesString)
-	data.Set("deleteFiles", strconv.FormatBool(keepFiles))
+	data.Set("hashes", strings.Join(hashes, "|"))
+	data.Set("location", location)
 
-	path := c.BasePath.JoinPath("/api/v2/torrents/delete")
+	return c.postForm(ctx, "/api/v2/torrents/setLocation", data)
+}
+
+// SetDownloadLimit sets the download limit of the torrents in bytes per second, 0 for no limit.
+// https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)#set-torrent-download-limit
+func (c *Client) SetDownloadLimit(ctx context.Context, hashes []string, limit int64) error {
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+	data.Set("limit", strconv.FormatInt(limit, 10))
+
+	return c.postForm(ctx, "/api/v2/torrents/setDownloadLimit", data)
+}
+
+// SetUploadLimit sets the upload limit of the torrents in bytes per second, 0 for no limit.
+// https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)#set-torrent-upload-limit
+func (c *Client) SetUploadLimit(ctx context.Context, hashes []string, limit int64) error {
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+	data.Set("limit", strconv.FormatInt(limit, 10))
+
+	return c.postForm(ctx, "/api/v2/torrents/setUploadLimit", data)
+}
+
+// ShareLimits are when a torrent stops seeding. -2 uses the server's limit, -1 is no limit. Times are minutes.
+type ShareLimits struct {
+	RatioLimit               float64
+	SeedingTimeLimit         int
+	InactiveSeedingTimeLimit int
+}
+
+// SetShareLimits sets the share limits of the torrents.
+// https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)#set-torrent-share-limit
+func (c *Client) SetShareLimits(ctx context.Context, hashes []string, limits ShareLimits) error {
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+	data.Set("ratioLimit", strconv.FormatFloat(limits.RatioLimit, 'f', -1, 64))
+	data.Set("seedingTimeLimit", strconv.Itoa(limits.SeedingTimeLimit))
+	data.Set("inactiveSeedingTimeLimit", strconv.Itoa(limits.InactiveSeedingTimeLimit))
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, path.String(), strings.NewReader(data.Encode()))
+	return c.postForm(ctx, "/api/v2/torrents/setShareLimits", data)
+}
+
+func (c *Client) GetVersion(ctx context.Context) (string, error) {
+	//https://{{hostname}}/api/v2/app/webapiVersion
+
+	body, err := c.get(ctx, "/api/v2/app/webapiVersion", nil)
 	if err != nil {
-		return err
+		return "", err
+	}
+	return strings.TrimSpace(string(body)), nil
+}
+
+// GetPreferences returns the application preferences of the server.
+// https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)#get-application-preferences
+func (c *Client) GetPreferences(ctx context.Context) (*Preferences, error) {
+	rtnMe := &Preferences{}
+	err := c.getJSON(ctx, "/api/v2/app/preferences", nil, rtnMe)
+	if err != nil {
+		return nil, err
+	}
+
+	return rtnMe, nil
+}
+
+// SetPreferences applies a partial set of preferences. Keys that aren't in patch are left untouched by qBittorrent.
+// https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)#set-application-preferences
+func (c *Client) SetPreferences(ctx context.Context, patch map[string]any) error {
+	if len(patch) == 0 {
+		return nil
 	}
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=UTF-8")
-	c.attachAuthHeader(req)
 
-	resp, err := httpClient.Do(req)
+	patchJson, err := json.Marshal(patch)
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != 200 {
-		return errors.New(resp.Status)
+	data := url.Values{}
+	data.Set("json", string(patchJson))
+
+	return c.postForm(ctx, "/api/v2/app/setPreferences", data)
+}
+
+var QueueingDisabledError = fmt.Errorf("torrent queueing is not enabled: %w", ErrConflict)
+
+// IncreasePriority moves the torrents one position up in the queue.
+// https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)#increase-torrent-priority
+func (c *Client) IncreasePriority(ctx context.Context, hashes []string) error {
+	return c.changeQueuePosition(ctx, "increasePrio", hashes)
+}
+
+// DecreasePriority moves the torrents one position down in the queue.
+// https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)#decrease-torrent-priority
+func (c *Client) DecreasePriority(ctx context.Context, hashes []string) error {
+	return c.changeQueuePosition(ctx, "decreasePrio", hashes)
+}
+
+// TopPriority moves the torrents to the top of the queue.
+// https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)#maximal-torrent-priority
+func (c *Client) TopPriority(ctx context.Context, hashes []string) error {
+	return c.changeQueuePosition(ctx, "topPrio", hashes)
+}
+
+// BottomPriority moves the torrents to the bottom of the queue.
+// https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)#minimal-torrent-priority
+func (c *Client) BottomPriority(ctx context.Context, hashes []string) error {
+	return c.changeQueuePosition(ctx, "bottomPrio", hashes)
+}
+
+func (c *Client) changeQueuePosition(ctx context.Context, action string, hashes []string) error {
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+
+	err := c.postForm(ctx, "/api/v2/torrents/"+action, data)
+	if errors.Is(err, ErrConflict) {
+		return QueueingDisabledError
 	}
-	return nil
+
+	return err
 }
 
-func (c *Client) GetVersion(ctx context.Context) (string, error) {
-	//https://{{hostname}}/api/v2/app/webapiVersion
+// ExportTorrent returns the .torrent file of the torrent. The caller must close the returned reader.
+// Only available on WebAPI 2.8.14 and later, older servers return ErrUnsupportedAPI.
+func (c *Client) ExportTorrent(ctx context.Context, hash string) (io.ReadCloser, error) {
+	supported, err := c.HasCapability(ctx, CapabilityExport)
+	if err != nil {
+		return nil, err
+	}
+	if !supported {
+		return nil, fmt.Errorf("export: %w", ErrUnsupportedAPI)
+	}
+
+	data := url.Values{}
+	data.Set("hash", hash)
 
-	currPath := c.BasePath.JoinPath("/api/v2/app/webapiVersion")
+	path := c.BasePath.JoinPath("/api/v2/torrents/export")
+	path.RawQuery = data.Encode()
 
-	req, err := http.NewRequestWithContext(ctx, "GET", currPath.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, path.String(), nil)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	c.attachAuthHeader(req)
 
-	resp, err := httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
-		return "", err
+		if errors.Is(err, ErrNotFound) {
+			return nil, TorrentNotFoundError
+		}
+		return nil, err
 	}
 
-	defer resp.Body.Close()
-	body, _ := io.ReadAll(resp.Body)
-	return string(body), nil
+	return resp.Body, nil
 }
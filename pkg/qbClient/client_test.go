@@ -0,0 +1,513 @@
+package qbClient_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbFake"
+)
+
+var testTorrents = []qbFake.Torrent{
+	{Name: "debian.iso", Category: "linux", Tags: "keep", Size: 100},
+	{Name: "ubuntu.iso", Category: "linux", Size: 200},
+	{Name: "movie.mkv", Category: "movies", Tags: "keep, hd", Size: 300},
+}
+
+func newClient(t *testing.T, options ...qbFake.Option) (*qbClient.Client, *qbFake.Server) {
+	t.Helper()
+
+	server := qbFake.New(options...)
+	t.Cleanup(server.Close)
+
+	client, err := qbClient.Login(context.Background(), server.Login("fake"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, server
+}
+
+func TestGetTorrents(t *testing.T) {
+	client, _ := newClient(t, qbFake.WithTorrents(testTorrents...))
+
+	torrents, err := client.GetTorrents(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(torrents) != len(testTorrents) {
+		t.Fatalf("got %d torrents, want %d", len(torrents), len(testTorrents))
+	}
+	for i, torrent := range torrents {
+		if torrent.Name != testTorrents[i].Name {
+			t.Errorf("torrents[%d].Name = %s, want %s", i, torrent.Name, testTorrents[i].Name)
+		}
+		if torrent.Client != client {
+			t.Errorf("torrents[%d].Client isn't the client it came from", i)
+		}
+	}
+	if torrents[0].AddedOn.Time().IsZero() {
+		t.Error("AddedOn wasn't parsed")
+	}
+}
+
+func TestGetTorrentNotFound(t *testing.T) {
+	client, _ := newClient(t, qbFake.WithTorrents(testTorrents...))
+
+	_, err := client.GetTorrent(context.Background(), "does-not-exist")
+	if !errors.Is(err, qbClient.ErrNotFound) {
+		t.Fatalf("got %v, want ErrNotFound", err)
+	}
+}
+
+func TestTrackersAndFiles(t *testing.T) {
+	client, server := newClient(t, qbFake.WithTorrents(qbFake.Torrent{
+		Name:     "debian.iso",
+		Trackers: []qbClient.TorrentTracker{{Url: "udp://tracker.example:6969", Status: 4, Msg: "Unregistered torrent"}},
+		Files:    []qbClient.TorrentFile{{Name: "debian.iso", Size: 100, Progress: 1}},
+	}))
+	hash := server.HashOf("debian.iso")
+
+	trackers, err := client.GetTracker(context.Background(), hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trackers) != 1 || trackers[0].StatusString() != "Not Working" {
+		t.Errorf("got trackers %+v", trackers)
+	}
+
+	files, err := client.GetFilesInTorrent(context.Background(), hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].Name != "debian.iso" {
+		t.Errorf("got files %+v", files)
+	}
+
+	_, err = client.GetTracker(context.Background(), "does-not-exist")
+	if !errors.Is(err, qbClient.ErrNotFound) {
+		t.Errorf("got %v, want ErrNotFound", err)
+	}
+}
+
+func TestCategories(t *testing.T) {
+	client, server := newClient(t)
+	ctx := context.Background()
+
+	err := client.CreateCategoryIfNotExist(ctx, &qbClient.Category{Name: "linux", SavePath: "/data/linux"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.Categories()["linux"].SavePath != "/data/linux" {
+		t.Errorf("category wasn't created, got %+v", server.Categories())
+	}
+
+	categories, err := client.GetCategories(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, exist := categories["linux"]; !exist {
+		t.Errorf("got %+v, want linux", categories)
+	}
+
+	// Already on the server according to Servers, nothing is sent.
+	before := server.Requests("/api/v2/torrents/createCategory")
+	err = client.CreateCategoryIfNotExist(ctx, &qbClient.Category{Name: "linux", Servers: []string{"fake"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.Requests("/api/v2/torrents/createCategory") != before {
+		t.Error("category was created again")
+	}
+
+	err = client.CreateCategoryIfNotExist(ctx, &qbClient.Category{Name: "linux"})
+	if !errors.Is(err, qbClient.ErrConflict) {
+		t.Errorf("got %v, want ErrConflict", err)
+	}
+
+	err = client.CreateCategoryIfNotExist(ctx, &qbClient.Category{})
+	var apiErr *qbClient.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("got %v, want a 400", err)
+	}
+}
+
+func TestPauseResume(t *testing.T) {
+	tests := []struct {
+		version     string
+		pausedState string
+		endpoint    string
+	}{
+		{qbFake.DefaultVersion, "stoppedUP", "/api/v2/torrents/stop"},
+		{qbFake.LegacyVersion, "pausedUP", "/api/v2/torrents/pause"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			client, server := newClient(t, qbFake.WithVersion(test.version), qbFake.WithTorrents(testTorrents...))
+			ctx := context.Background()
+			hash := server.HashOf("debian.iso")
+
+			err := client.PauseTorrents(ctx, []string{hash})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if torrent, _ := server.Torrent(hash); torrent.State != test.pausedState {
+				t.Errorf("state = %s, want %s", torrent.State, test.pausedState)
+			}
+			if server.Requests(test.endpoint) != 1 {
+				t.Errorf("%s wasn't used", test.endpoint)
+			}
+
+			err = client.ResumeTorrents(ctx, []string{hash})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if torrent, _ := server.Torrent(hash); torrent.State != "stalledUP" {
+				t.Errorf("state = %s, want stalledUP", torrent.State)
+			}
+		})
+	}
+}
+
+func TestDeleteTorrent(t *testing.T) {
+	client, server := newClient(t, qbFake.WithTorrents(testTorrents...))
+	hash := server.HashOf("ubuntu.iso")
+
+	err := client.DeleteTorrent(context.Background(), []string{hash}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, exist := server.Torrent(hash); exist {
+		t.Error("torrent wasn't deleted")
+	}
+	if len(server.Torrents()) != len(testTorrents)-1 {
+		t.Errorf("got %d torrents left", len(server.Torrents()))
+	}
+}
+
+func TestQueue(t *testing.T) {
+	client, server := newClient(t, qbFake.WithTorrents(testTorrents...))
+	ctx := context.Background()
+	hash := server.HashOf("movie.mkv")
+
+	err := client.TopPriority(ctx, []string{hash})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if torrent, _ := server.Torrent(hash); torrent.Priority != 1 {
+		t.Errorf("priority = %d after top, want 1", torrent.Priority)
+	}
+
+	err = client.DecreasePriority(ctx, []string{hash})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if torrent, _ := server.Torrent(hash); torrent.Priority != 2 {
+		t.Errorf("priority = %d after decrease, want 2", torrent.Priority)
+	}
+
+	err = client.BottomPriority(ctx, []string{hash})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if torrent, _ := server.Torrent(hash); torrent.Priority != 3 {
+		t.Errorf("priority = %d after bottom, want 3", torrent.Priority)
+	}
+}
+
+func TestQueueingDisabled(t *testing.T) {
+	client, server := newClient(t, qbFake.WithQueueing(false), qbFake.WithTorrents(testTorrents...))
+
+	err := client.IncreasePriority(context.Background(), []string{server.HashOf("movie.mkv")})
+	if !errors.Is(err, qbClient.QueueingDisabledError) {
+		t.Fatalf("got %v, want QueueingDisabledError", err)
+	}
+}
+
+func TestExportTorrent(t *testing.T) {
+	client, server := newClient(t, qbFake.WithTorrents(qbFake.Torrent{Name: "debian.iso", Content: []byte("d4:infodee")}))
+
+	body, err := client.ExportTorrent(context.Background(), server.HashOf("debian.iso"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer body.Close()
+
+	content, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "d4:infodee" {
+		t.Errorf("got %q", content)
+	}
+
+	_, err = client.ExportTorrent(context.Background(), "does-not-exist")
+	if !errors.Is(err, qbClient.ErrNotFound) {
+		t.Errorf("got %v, want ErrNotFound", err)
+	}
+}
+
+func TestExportTorrentUnsupported(t *testing.T) {
+	client, server := newClient(t, qbFake.WithVersion("2.8.3"), qbFake.WithTorrents(testTorrents...))
+
+	_, err := client.ExportTorrent(context.Background(), server.HashOf("debian.iso"))
+	if !errors.Is(err, qbClient.ErrUnsupportedAPI) {
+		t.Fatalf("got %v, want ErrUnsupportedAPI", err)
+	}
+}
+
+func TestUploadTorrentFiles(t *testing.T) {
+	client, server := newClient(t)
+
+	_, err := client.UploadTorrentFiles(context.Background(), []qbClient.UploadTorrentInfo{
+		{Filename: "arch.torrent", File: strings.NewReader("d4:infodee")},
+	}, "linux")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	torrents := server.Torrents()
+	if len(torrents) != 1 || torrents[0].Name != "arch" || torrents[0].Category != "linux" {
+		t.Errorf("got %+v", torrents)
+	}
+
+	_, err = client.UploadTorrentFiles(context.Background(), []qbClient.UploadTorrentInfo{
+		{Filename: "broken.torrent", File: strings.NewReader("not a torrent")},
+	}, "")
+	var apiErr *qbClient.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnsupportedMediaType {
+		t.Errorf("got %v, want a 415", err)
+	}
+}
+
+func TestPreferences(t *testing.T) {
+	client, server := newClient(t)
+	ctx := context.Background()
+
+	preferences, err := client.GetPreferences(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if preferences.MaxActiveTorrents != 5 {
+		t.Errorf("MaxActiveTorrents = %d, want 5", preferences.MaxActiveTorrents)
+	}
+	if _, exist := preferences.Extra["web_ui_custom_http_headers_enabled"]; !exist {
+		t.Error("unknown preference wasn't kept in Extra")
+	}
+
+	err = client.SetPreferences(ctx, map[string]any{"max_active_torrents": 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.Preferences()["max_active_torrents"] != float64(10) {
+		t.Errorf("got %v, want 10", server.Preferences()["max_active_torrents"])
+	}
+}
+
+func TestDetectVersion(t *testing.T) {
+	client, _ := newClient(t, qbFake.WithVersion(qbFake.LegacyVersion))
+
+	err := client.DetectVersion(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.Version() != qbFake.LegacyVersion {
+		t.Errorf("Version() = %s", client.Version())
+	}
+	if slices.Contains(client.Capabilities(), qbClient.CapabilityStopStart) {
+		t.Error("legacy version has stop/start")
+	}
+}
+
+func TestApiKeyAuth(t *testing.T) {
+	server := qbFake.New(qbFake.WithApiKey("secret"))
+	t.Cleanup(server.Close)
+
+	client, err := qbClient.Login(context.Background(), server.Login("fake"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Validate(context.Background()); err != nil {
+		t.Fatalf("valid key: %v", err)
+	}
+
+	login := server.Login("fake")
+	login.ApiKey = "wrong"
+	client, err = qbClient.Login(context.Background(), login)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = client.Validate(context.Background())
+	if !errors.Is(err, qbClient.ErrUnauthorized) {
+		t.Fatalf("got %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestPasswordAuth(t *testing.T) {
+	server := qbFake.New(qbFake.WithLogin("admin", "adminadmin"), qbFake.WithTorrents(testTorrents...))
+	t.Cleanup(server.Close)
+	ctx := context.Background()
+
+	client, err := qbClient.Login(ctx, server.Login("fake"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetTorrents(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The session is dropped, the client logs in again and the request still succeeds.
+	server.ExpireSessions()
+	_, err = client.GetTorrents(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if logins := server.Requests("/api/v2/auth/login"); logins != 2 {
+		t.Errorf("logged in %d times, want 2", logins)
+	}
+
+	login := server.Login("fake")
+	login.Password = configuration.Secret("wrong")
+	client, err = qbClient.Login(ctx, login)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetTorrents(ctx)
+	if !errors.Is(err, qbClient.ErrUnauthorized) {
+		t.Fatalf("got %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestRetryOnServerError(t *testing.T) {
+	client, server := newClient(t, qbFake.WithTorrents(testTorrents...))
+	server.InjectFault("/api/v2/torrents/info", qbFake.Fault{Status: http.StatusInternalServerError, Times: 2})
+
+	torrents, err := client.GetTorrents(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(torrents) != len(testTorrents) {
+		t.Errorf("got %d torrents", len(torrents))
+	}
+	if requests := server.Requests("/api/v2/torrents/info"); requests != 3 {
+		t.Errorf("sent %d requests, want 3", requests)
+	}
+}
+
+func TestNoRetryOnPost(t *testing.T) {
+	client, server := newClient(t, qbFake.WithTorrents(testTorrents...))
+	server.InjectFault("/api/v2/torrents/delete", qbFake.Fault{Status: http.StatusInternalServerError, Times: 1})
+
+	err := client.DeleteTorrent(context.Background(), []string{server.HashOf("debian.iso")}, false)
+	if err == nil {
+		t.Fatal("got no error")
+	}
+	if requests := server.Requests("/api/v2/torrents/delete"); requests != 1 {
+		t.Errorf("sent %d requests, want 1", requests)
+	}
+}
+
+func TestForbidden(t *testing.T) {
+	client, server := newClient(t)
+	server.InjectFault("/api/v2/torrents/categories", qbFake.Fault{Status: http.StatusForbidden})
+
+	_, err := client.GetCategories(context.Background())
+	if !errors.Is(err, qbClient.ErrUnauthorized) {
+		t.Fatalf("got %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestMalformedJSON(t *testing.T) {
+	client, server := newClient(t)
+	server.InjectFault("/api/v2/torrents/info", qbFake.Fault{MalformedJSON: true})
+
+	_, err := client.GetTorrents(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "/api/v2/torrents/info") {
+		t.Fatalf("got %v, want a decode error naming the endpoint", err)
+	}
+}
+
+func TestLatencyTimeout(t *testing.T) {
+	server := qbFake.New()
+	t.Cleanup(server.Close)
+	server.InjectFault("", qbFake.Fault{Latency: time.Second})
+
+	login := server.Login("fake")
+	login.Transport.ReadTimeout = configuration.Duration(50 * time.Millisecond)
+	login.Transport.MaxRetries = -1
+	client, err := qbClient.Login(context.Background(), login)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	_, err = client.GetVersion(context.Background())
+	if err == nil {
+		t.Fatal("got no error")
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("took %s, the read timeout wasn't applied", elapsed)
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	server := qbFake.New()
+	t.Cleanup(server.Close)
+	server.InjectFault("", qbFake.Fault{Status: http.StatusInternalServerError})
+
+	login := server.Login("fake")
+	login.Transport.MaxRetries = -1
+	login.Transport.BreakerThreshold = 2
+	login.Transport.BreakerCooldown = configuration.Duration(time.Hour)
+	client, err := qbClient.Login(context.Background(), login)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for range 2 {
+		_, _ = client.GetVersion(context.Background())
+	}
+	if client.Available() {
+		t.Error("breaker didn't open")
+	}
+
+	_, err = client.GetVersion(context.Background())
+	if !errors.Is(err, qbClient.ErrServerUnavailable) {
+		t.Errorf("got %v, want ErrServerUnavailable", err)
+	}
+	if requests := server.Requests("/api/v2/app/webapiVersion"); requests != 2 {
+		t.Errorf("sent %d requests, want 2", requests)
+	}
+}
+
+func TestProbe(t *testing.T) {
+	client, server := newClient(t)
+	settings := configuration.Health{
+		Timeout:         configuration.Duration(time.Second),
+		DegradedLatency: configuration.Duration(time.Second),
+		DownAfter:       2,
+	}
+
+	if status := client.Probe(context.Background(), settings).Status; status != qbClient.HealthUp {
+		t.Errorf("status = %s, want up", status)
+	}
+
+	server.InjectFault("", qbFake.Fault{Status: http.StatusForbidden})
+	if status := client.Probe(context.Background(), settings).Status; status != qbClient.HealthDegraded {
+		t.Errorf("status = %s after one failure, want degraded", status)
+	}
+	health := client.Probe(context.Background(), settings)
+	if health.Status != qbClient.HealthDown || health.LastError == "" {
+		t.Errorf("got %+v after two failures, want down with an error", health)
+	}
+}
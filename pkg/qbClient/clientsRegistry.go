@@ -2,22 +2,119 @@ package qbClient
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"reflect"
+	"slices"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
 )
 
+// detectVersionTimeout stops a hung server from blocking startup.
+const detectVersionTimeout = 10 * time.Second
+
+var (
+	ErrClientExists   = errors.New("server already registered")
+	ErrClientNotFound = errors.New("server not found in registry")
+)
+
+type RegistryEventType string
+
+const (
+	RegistryClientAdded    RegistryEventType = "added"
+	RegistryClientRemoved  RegistryEventType = "removed"
+	RegistryClientReplaced RegistryEventType = "replaced"
+)
+
+// RegistryEvent is sent to every listener after the registry changes.
+// Client is the new client, or the removed one for RegistryClientRemoved.
+type RegistryEvent struct {
+	Type   RegistryEventType
+	Key    string
+	Client *Client
+}
+
+// ClientRegistry holds the clients keyed by their name. It's safe for concurrent use.
 type ClientRegistry struct {
-	clients map[string]*Client
+	mu        sync.RWMutex
+	clients   map[string]*Client
+	listeners []func(RegistryEvent)
 }
 
-func (r *ClientRegistry) Get(basePath string) (*Client, bool) {
-	curr, ok := r.clients[basePath]
-	return curr, ok
+func NewClientRegistry() *ClientRegistry {
+	return &ClientRegistry{clients: make(map[string]*Client)}
+}
+
+// Get returns the client with the name. The base path is also accepted so links made before servers had names work.
+func (r *ClientRegistry) Get(name string) (*Client, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	curr, ok := r.clients[name]
+	if ok {
+		return curr, true
+	}
+
+	for _, client := range r.clients {
+		if client.BasePath.String() == name {
+			return client, true
+		}
+	}
+	return nil, false
+}
+
+// Resolve returns the clients for every ref, where a ref is a server name, a group or a base path.
+// Each client is only returned once, in the order of refs. It fails with ErrClientNotFound on the first ref
+// that doesn't match anything.
+func (r *ClientRegistry) Resolve(refs []string) ([]*Client, error) {
+	rtnMe := make([]*Client, 0, len(refs))
+	seen := make(map[*Client]bool)
+
+	for _, ref := range refs {
+		matches := r.resolveOne(ref)
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("%s: %w", ref, ErrClientNotFound)
+		}
+
+		for _, client := range matches {
+			if !seen[client] {
+				seen[client] = true
+				rtnMe = append(rtnMe, client)
+			}
+		}
+	}
+
+	return rtnMe, nil
+}
+
+func (r *ClientRegistry) resolveOne(ref string) []*Client {
+	if client, exist := r.Get(ref); exist {
+		return []*Client{client}
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rtnMe := make([]*Client, 0)
+	for _, client := range r.clients {
+		if client.Group != "" && client.Group == ref {
+			rtnMe = append(rtnMe, client)
+		}
+	}
+	slices.SortFunc(rtnMe, func(a, b *Client) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return rtnMe
 }
 
 func (r *ClientRegistry) All() []*Client {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	result := make([]*Client, 0, len(r.clients))
 	for _, client := range r.clients {
 		result = append(result, client)
@@ -25,21 +122,173 @@ func (r *ClientRegistry) All() []*Client {
 	return result
 }
 
+// Add registers client, it fails with ErrClientExists if a client with the same name or base path is registered.
+func (r *ClientRegistry) Add(client *Client) error {
+	key := client.Name
+
+	r.mu.Lock()
+	for name, curr := range r.clients {
+		if name == key || curr.BasePath.String() == client.BasePath.String() {
+			r.mu.Unlock()
+			return ErrClientExists
+		}
+	}
+	r.clients[key] = client
+	r.mu.Unlock()
+
+	r.notify(RegistryEvent{Type: RegistryClientAdded, Key: key, Client: client})
+	return nil
+}
+
+// Remove unregisters the client with the name, it fails with ErrClientNotFound if there isn't one.
+func (r *ClientRegistry) Remove(name string) error {
+	r.mu.Lock()
+	client, exist := r.clients[name]
+	if !exist {
+		r.mu.Unlock()
+		return ErrClientNotFound
+	}
+	delete(r.clients, name)
+	r.mu.Unlock()
+
+	r.notify(RegistryEvent{Type: RegistryClientRemoved, Key: name, Client: client})
+	return nil
+}
+
+// Replace swaps the registered client that has the same name as client. Requests already running on the
+// old client finish on it. It fails with ErrClientNotFound if there isn't one.
+func (r *ClientRegistry) Replace(client *Client) error {
+	key := client.Name
+
+	r.mu.Lock()
+	if _, exist := r.clients[key]; !exist {
+		r.mu.Unlock()
+		return ErrClientNotFound
+	}
+	r.clients[key] = client
+	r.mu.Unlock()
+
+	r.notify(RegistryEvent{Type: RegistryClientReplaced, Key: key, Client: client})
+	return nil
+}
+
+// OnChange registers listener to be called after every Add, Remove and Replace.
+// Listeners are called synchronously in the order they were registered, so they must not block.
+func (r *ClientRegistry) OnChange(listener func(RegistryEvent)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.listeners = append(r.listeners, listener)
+}
+
+func (r *ClientRegistry) notify(event RegistryEvent) {
+	r.mu.RLock()
+	listeners := append([]func(RegistryEvent){}, r.listeners...)
+	r.mu.RUnlock()
+
+	slog.Debug("Client registry changed", "event", event.Type, "server", event.Key)
+	for _, listener := range listeners {
+		listener(event)
+	}
+}
+
+// NewClient logs in to the endpoint and detects its WebAPI version.
+// Failing to detect the version isn't fatal, it's detected again the first time it's needed.
+func NewClient(login configuration.QbLogin) (*Client, error) {
+	currClient, err := Login(context.Background(), login)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), detectVersionTimeout)
+	defer cancel()
+
+	err = currClient.DetectVersion(ctx)
+	if err != nil {
+		slog.Warn("Failed to detect WebAPI version", "hostname", login.Path, "error", err)
+	}
+
+	return currClient, nil
+}
+
 var Registry = sync.OnceValue(func() *ClientRegistry {
 	cfg := configuration.MustGetConfig()
-	clients := make(map[string]*Client)
+	registry := NewClientRegistry()
 
 	for _, v := range cfg.Endpoints {
-		currClient, err := Login(context.Background(), configuration.QbLogin{
-			Path:   v.Path,
-			ApiKey: v.ApiKey,
-		})
+		currClient, err := NewClient(v)
 		if err != nil {
-			slog.Error("Failed to connect to client", "hostname", v.Path)
+			slog.Error("Failed to connect to client", "hostname", v.Path, "error", err)
 			continue
 		}
-		clients[currClient.BasePath.String()] = currClient
+
+		err = registry.Add(currClient)
+		if err != nil {
+			slog.Error("Failed to register client", "hostname", v.Path, "error", err)
+		}
 	}
 
-	return &ClientRegistry{clients: clients}
+	return registry
 })
+
+// Sync applies the difference between two versions of the config's endpoints. Endpoints removed from the file
+// are removed, new ones are added and changed ones are replaced. Servers added at runtime and endpoints whose file
+// entry didn't change are left alone, so a reload doesn't undo addServer or updateServerCredentials. It returns
+// one line per applied change for logging.
+func (r *ClientRegistry) Sync(old, new []configuration.QbLogin) []string {
+	changes := make([]string, 0)
+
+	oldByName := make(map[string]configuration.QbLogin)
+	for _, endpoint := range old {
+		oldByName[endpoint.GetName()] = endpoint
+	}
+	newByName := make(map[string]configuration.QbLogin)
+	for _, endpoint := range new {
+		newByName[endpoint.GetName()] = endpoint
+	}
+
+	// Removed first so an endpoint that was renamed doesn't clash with its old entry.
+	for _, endpoint := range old {
+		key := endpoint.GetName()
+		if _, exist := newByName[key]; exist {
+			continue
+		}
+		if err := r.Remove(key); err == nil {
+			changes = append(changes, "removed "+key)
+		} else if !errors.Is(err, ErrClientNotFound) {
+			slog.Error("Failed to remove client", "hostname", endpoint.Path, "error", err)
+		}
+	}
+
+	for _, endpoint := range new {
+		key := endpoint.GetName()
+		if previous, exist := oldByName[key]; exist && reflect.DeepEqual(previous, endpoint) {
+			continue
+		}
+
+		client, err := NewClient(endpoint)
+		if err != nil {
+			slog.Error("Failed to connect to client", "hostname", endpoint.Path, "error", err)
+			continue
+		}
+
+		r.mu.RLock()
+		_, exist := r.clients[key]
+		r.mu.RUnlock()
+
+		change := "added "
+		if exist {
+			err = r.Replace(client)
+			change = "updated "
+		} else {
+			err = r.Add(client)
+		}
+		if err != nil {
+			slog.Error("Failed to register client", "hostname", endpoint.Path, "error", err)
+			continue
+		}
+		changes = append(changes, change+key)
+	}
+
+	return changes
+}
@@ -0,0 +1,174 @@
+package qbClient_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbFake"
+)
+
+func newRegistry(t *testing.T, logins ...configuration.QbLogin) *qbClient.ClientRegistry {
+	t.Helper()
+
+	registry := qbClient.NewClientRegistry()
+	for _, login := range logins {
+		client, err := qbClient.Login(context.Background(), login)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = registry.Add(client); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return registry
+}
+
+func names(clients []*qbClient.Client) []string {
+	rtnMe := make([]string, 0, len(clients))
+	for _, client := range clients {
+		rtnMe = append(rtnMe, client.Name)
+	}
+	return rtnMe
+}
+
+func TestRegistryResolve(t *testing.T) {
+	servers := []*qbFake.Server{qbFake.New(), qbFake.New(), qbFake.New()}
+	logins := make([]configuration.QbLogin, 0, len(servers))
+	for i, server := range servers {
+		t.Cleanup(server.Close)
+		login := server.Login([]string{"eu-1", "eu-2", "us-1"}[i])
+		if i < 2 {
+			login.Group = "eu"
+		}
+		logins = append(logins, login)
+	}
+	registry := newRegistry(t, logins...)
+
+	tests := []struct {
+		refs []string
+		want []string
+	}{
+		{[]string{"us-1"}, []string{"us-1"}},
+		{[]string{"eu"}, []string{"eu-1", "eu-2"}},
+		{[]string{"eu-2", "eu"}, []string{"eu-2", "eu-1"}},
+		{[]string{servers[2].URL}, []string{"us-1"}},
+	}
+	for _, test := range tests {
+		clients, err := registry.Resolve(test.refs)
+		if err != nil {
+			t.Fatalf("Resolve(%v): %v", test.refs, err)
+		}
+		if got := names(clients); !slices.Equal(got, test.want) {
+			t.Errorf("Resolve(%v) = %v, want %v", test.refs, got, test.want)
+		}
+	}
+
+	_, err := registry.Resolve([]string{"asia"})
+	if !errors.Is(err, qbClient.ErrClientNotFound) {
+		t.Errorf("got %v, want ErrClientNotFound", err)
+	}
+}
+
+func TestRegistryAddRemove(t *testing.T) {
+	server := qbFake.New()
+	t.Cleanup(server.Close)
+	registry := newRegistry(t, server.Login("fake"))
+
+	events := make([]qbClient.RegistryEventType, 0)
+	registry.OnChange(func(event qbClient.RegistryEvent) {
+		events = append(events, event.Type)
+	})
+
+	client, err := qbClient.Login(context.Background(), server.Login("other-name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = registry.Add(client); !errors.Is(err, qbClient.ErrClientExists) {
+		t.Errorf("adding the same path twice: got %v, want ErrClientExists", err)
+	}
+
+	if err = registry.Remove("fake"); err != nil {
+		t.Fatal(err)
+	}
+	if err = registry.Remove("fake"); !errors.Is(err, qbClient.ErrClientNotFound) {
+		t.Errorf("got %v, want ErrClientNotFound", err)
+	}
+
+	if !slices.Equal(events, []qbClient.RegistryEventType{qbClient.RegistryClientRemoved}) {
+		t.Errorf("got events %v", events)
+	}
+}
+
+func TestRegistrySync(t *testing.T) {
+	first, second, runtime := qbFake.New(), qbFake.New(), qbFake.New()
+	t.Cleanup(first.Close)
+	t.Cleanup(second.Close)
+	t.Cleanup(runtime.Close)
+
+	registry := newRegistry(t, first.Login("first"))
+	original, _ := registry.Get("first")
+
+	file := []configuration.QbLogin{first.Login("first")}
+	reloaded := []configuration.QbLogin{first.Login("first"), second.Login("second")}
+	changes := registry.Sync(file, reloaded)
+	if !slices.Equal(changes, []string{"added second"}) {
+		t.Errorf("got changes %v", changes)
+	}
+	if current, _ := registry.Get("first"); current != original {
+		t.Error("unchanged endpoint was replaced")
+	}
+
+	// A server added at runtime and an endpoint changed at runtime survive a reload that doesn't touch them.
+	added, err := qbClient.Login(context.Background(), runtime.Login("runtime"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = registry.Add(added); err != nil {
+		t.Fatal(err)
+	}
+	changedLogin := first.Login("first")
+	changedLogin.Group = "changed-at-runtime"
+	changed, err := qbClient.Login(context.Background(), changedLogin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = registry.Replace(changed); err != nil {
+		t.Fatal(err)
+	}
+
+	updated := second.Login("second")
+	updated.Group = "new-group"
+	file, reloaded = reloaded, []configuration.QbLogin{first.Login("first"), updated}
+	changes = registry.Sync(file, reloaded)
+	if !slices.Equal(changes, []string{"updated second"}) {
+		t.Errorf("got changes %v", changes)
+	}
+	if current, _ := registry.Get("second"); current.Group != "new-group" {
+		t.Error("changed endpoint wasn't replaced")
+	}
+	if current, _ := registry.Get("first"); current != changed {
+		t.Error("endpoint changed at runtime was reverted")
+	}
+	if _, exist := registry.Get("runtime"); !exist {
+		t.Error("server added at runtime was removed")
+	}
+
+	file, reloaded = reloaded, []configuration.QbLogin{updated}
+	changes = registry.Sync(file, reloaded)
+	if !slices.Equal(changes, []string{"removed first"}) {
+		t.Errorf("got changes %v", changes)
+	}
+	if got := names(registry.All()); len(got) != 2 {
+		t.Errorf("got servers %v", got)
+	}
+
+	// A change that fails to apply isn't reported, this one has the path of the server added at runtime.
+	duplicate := []configuration.QbLogin{updated, runtime.Login("duplicate")}
+	if changes = registry.Sync(reloaded, duplicate); len(changes) != 0 {
+		t.Errorf("got changes %v for an endpoint that couldn't be added", changes)
+	}
+}
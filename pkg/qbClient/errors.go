@@ -0,0 +1,50 @@
+package qbClient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	// ErrUnauthorized is returned when qBittorrent rejects the api key. (401, 403)
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrNotFound is returned when the endpoint, or the torrent it was asked about, doesn't exist. (404)
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is returned when the request can't be applied in the server's current state. (409)
+	ErrConflict = errors.New("conflict")
+	// ErrUnsupportedAPI is returned when the server's WebAPI version doesn't have the endpoint.
+	ErrUnsupportedAPI = errors.New("not supported by this qBittorrent version")
+	// ErrServerUnavailable is returned without contacting the server while its circuit breaker is open.
+	ErrServerUnavailable = errors.New("server unavailable")
+)
+
+var TorrentNotFoundError = fmt.Errorf("torrent %w", ErrNotFound)
+
+// APIError is returned for every non 2xx response from qBittorrent.
+// Use errors.Is with the Err variables above to check the kind of failure.
+type APIError struct {
+	StatusCode int
+	Endpoint   string
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	if e.Body == "" {
+		return fmt.Sprintf("%s: %d %s", e.Endpoint, e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("%s: %d %s", e.Endpoint, e.StatusCode, e.Body)
+}
+
+func (e *APIError) Unwrap() error {
+	switch e.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrUnauthorized
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrConflict
+	default:
+		return nil
+	}
+}
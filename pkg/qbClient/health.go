@@ -0,0 +1,128 @@
+package qbClient
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+)
+
+type HealthStatus string
+
+const (
+	// HealthUnknown is a server that hasn't been probed yet.
+	HealthUnknown HealthStatus = "unknown"
+	HealthUp      HealthStatus = "up"
+	// HealthDegraded is a server that answers slowly, or has started failing but not for long enough to be down.
+	HealthDegraded HealthStatus = "degraded"
+	HealthDown     HealthStatus = "down"
+)
+
+// Health is the state of a server as of its last background probe.
+type Health struct {
+	Status              HealthStatus
+	LastCheck           time.Time
+	LastSuccess         time.Time
+	LastError           string
+	Latency             time.Duration
+	ConsecutiveFailures int
+}
+
+// Healthy reports whether the server counts towards readiness, degraded servers still serve requests.
+func (h Health) Healthy() bool {
+	return h.Status == HealthUp || h.Status == HealthDegraded
+}
+
+// clientHealth is embedded in Client so the state is dropped together with the client when it's replaced.
+type clientHealth struct {
+	healthMu sync.RWMutex
+	health   Health
+}
+
+// Health returns the state of the server as of its last probe.
+func (c *Client) Health() Health {
+	c.healthMu.RLock()
+	defer c.healthMu.RUnlock()
+
+	if c.health.Status == "" {
+		return Health{Status: HealthUnknown}
+	}
+	return c.health
+}
+
+// Probe asks the server for its WebAPI version and updates its health with the result.
+func (c *Client) Probe(ctx context.Context, settings configuration.Health) Health {
+	ctx, cancel := context.WithTimeout(ctx, settings.Timeout.Duration())
+	defer cancel()
+
+	start := time.Now()
+	_, err := c.GetVersion(ctx)
+	latency := time.Since(start)
+
+	c.healthMu.Lock()
+	defer c.healthMu.Unlock()
+
+	previous := c.health.Status
+	if previous == "" {
+		previous = HealthUnknown
+	}
+	c.health.LastCheck = start
+	c.health.Latency = latency
+
+	if err == nil {
+		c.health.LastSuccess = start
+		c.health.LastError = ""
+		c.health.ConsecutiveFailures = 0
+		c.health.Status = HealthUp
+		if latency > settings.DegradedLatency.Duration() {
+			c.health.Status = HealthDegraded
+		}
+	} else {
+		c.health.LastError = err.Error()
+		c.health.ConsecutiveFailures++
+		c.health.Status = HealthDegraded
+		if c.health.ConsecutiveFailures >= settings.DownAfter {
+			c.health.Status = HealthDown
+		}
+	}
+
+	if previous != c.health.Status {
+		slog.Info("Server health changed", "server", c.Name, "from", previous, "to", c.health.Status,
+			"latency", latency, "error", c.health.LastError)
+	}
+
+	return c.health
+}
+
+// ProbeHealth probes every registered server every health.interval from the current config, until ctx is done.
+// Servers are probed as soon as they're added or replaced, so their state isn't unknown until the next round.
+func (r *ClientRegistry) ProbeHealth(ctx context.Context) {
+	r.OnChange(func(event RegistryEvent) {
+		if event.Type == RegistryClientRemoved || ctx.Err() != nil {
+			return
+		}
+		go event.Client.Probe(ctx, configuration.MustGetConfig().Health)
+	})
+
+	for {
+		settings := configuration.MustGetConfig().Health
+
+		var wg sync.WaitGroup
+		for _, client := range r.All() {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				client.Probe(ctx, settings)
+			}()
+		}
+		wg.Wait()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(settings.Interval.Duration()):
+		}
+	}
+}
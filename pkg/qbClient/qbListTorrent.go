@@ -1,5 +1,7 @@
 package qbClient
 
+import "strings"
+
 type TorrentInfo struct {
 	Common
 	AddedOn                  JSONTime `json:"added_on"`
@@ -27,7 +29,7 @@ type TorrentInfo struct {
 	LastActivity             int      `json:"last_activity"`
 	MagnetUri                string   `json:"magnet_uri"`
 	MaxInactiveSeedingTime   int      `json:"max_inactive_seeding_time"`
-	MaxRatio                 int      `json:"max_ratio"`
+	MaxRatio                 float64  `json:"max_ratio"`
 	MaxSeedingTime           int      `json:"max_seeding_time"`
 	Name                     string   `json:"name"`
 	NumComplete              int      `json:"num_complete"`
@@ -39,7 +41,7 @@ type TorrentInfo struct {
 	Private                  bool     `json:"private"`
 	Progress                 float64  `json:"progress"`
 	Ratio                    float64  `json:"ratio"`
-	RatioLimit               int      `json:"ratio_limit"`
+	RatioLimit               float64  `json:"ratio_limit"`
 	Reannounce               int      `json:"reannounce"`
 	RootPath                 string   `json:"root_path"`
 	SavePath                 string   `json:"save_path"`
@@ -60,3 +62,15 @@ type TorrentInfo struct {
 	UploadedSession          int      `json:"uploaded_session"`
 	Upspeed                  int      `json:"upspeed"`
 }
+
+// TagList splits the comma separated tags qBittorrent returns. Ex. "keep, linux"
+func (t *TorrentInfo) TagList() []string {
+	rtnMe := make([]string, 0)
+	for _, tag := range strings.Split(t.Tags, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag != "" {
+			rtnMe = append(rtnMe, tag)
+		}
+	}
+	return rtnMe
+}
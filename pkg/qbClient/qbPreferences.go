@@ -0,0 +1,117 @@
+package qbClient
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Preferences is the application preferences returned by /api/v2/app/preferences.
+// Only the settings the panel cares about are typed, everything else is kept in Extra
+// so a read, modify, write round trip doesn't lose keys added by newer qBittorrent versions.
+// https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)#get-application-preferences
+type Preferences struct {
+	SavePath              string  `json:"save_path"`
+	TempPathEnabled       bool    `json:"temp_path_enabled"`
+	TempPath              string  `json:"temp_path"`
+	AutoTmmEnabled        bool    `json:"auto_tmm_enabled"`
+	ListenPort            int     `json:"listen_port"`
+	RandomPort            bool    `json:"random_port"`
+	Upnp                  bool    `json:"upnp"`
+	Encryption            int     `json:"encryption"`
+	AnonymousMode         bool    `json:"anonymous_mode"`
+	Dht                   bool    `json:"dht"`
+	Pex                   bool    `json:"pex"`
+	Lsd                   bool    `json:"lsd"`
+	QueueingEnabled       bool    `json:"queueing_enabled"`
+	MaxActiveDownloads    int     `json:"max_active_downloads"`
+	MaxActiveTorrents     int     `json:"max_active_torrents"`
+	MaxActiveUploads      int     `json:"max_active_uploads"`
+	MaxConnec             int     `json:"max_connec"`
+	MaxConnecPerTorrent   int     `json:"max_connec_per_torrent"`
+	MaxUploads            int     `json:"max_uploads"`
+	MaxUploadsPerTorrent  int     `json:"max_uploads_per_torrent"`
+	DlLimit               int     `json:"dl_limit"`
+	UpLimit               int     `json:"up_limit"`
+	AltDlLimit            int     `json:"alt_dl_limit"`
+	AltUpLimit            int     `json:"alt_up_limit"`
+	MaxRatioEnabled       bool    `json:"max_ratio_enabled"`
+	MaxRatio              float64 `json:"max_ratio"`
+	MaxSeedingTimeEnabled bool    `json:"max_seeding_time_enabled"`
+	MaxSeedingTime        int     `json:"max_seeding_time"`
+
+	// Extra holds every key that doesn't have a field above.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// preferencesAlias has the same fields as Preferences without its json methods.
+type preferencesAlias Preferences
+
+var preferenceKeys = sync.OnceValue(func() map[string]struct{} {
+	keys := make(map[string]struct{})
+	t := reflect.TypeFor[Preferences]()
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		keys[name] = struct{}{}
+	}
+	return keys
+})
+
+func (p *Preferences) UnmarshalJSON(b []byte) error {
+	var typed preferencesAlias
+	if err := json.Unmarshal(b, &typed); err != nil {
+		return err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	known := preferenceKeys()
+	for k := range raw {
+		if _, ok := known[k]; ok {
+			delete(raw, k)
+		}
+	}
+
+	*p = Preferences(typed)
+	p.Extra = raw
+	return nil
+}
+
+// MarshalJSON writes the typed fields and Extra back out as a single object.
+func (p Preferences) MarshalJSON() ([]byte, error) {
+	m, err := p.Map()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(m)
+}
+
+// Map returns every preference, typed or not, keyed by its qBittorrent name.
+func (p Preferences) Map() (map[string]json.RawMessage, error) {
+	typed, err := json.Marshal(preferencesAlias(p))
+	if err != nil {
+		return nil, err
+	}
+
+	rtnMe := make(map[string]json.RawMessage, len(p.Extra)+len(preferenceKeys()))
+	for k, v := range p.Extra {
+		rtnMe[k] = v
+	}
+
+	var typedMap map[string]json.RawMessage
+	if err = json.Unmarshal(typed, &typedMap); err != nil {
+		return nil, err
+	}
+	for k, v := range typedMap {
+		rtnMe[k] = v
+	}
+
+	return rtnMe, nil
+}
@@ -0,0 +1,174 @@
+package qbClient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// maxErrorBodySize caps how much of an error response is kept in APIError.Body.
+const maxErrorBodySize = 4096
+
+// do attaches the auth header and sends req. Any non 2xx response is closed and returned as an *APIError,
+// otherwise the caller must close the response body.
+// Idempotent requests are retried on network errors and 5xx, and nothing is sent while the circuit breaker is open.
+// With a username and password the client logs in first, and again once if the session has expired.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	if !c.usesPassword() {
+		return c.send(req)
+	}
+
+	err := c.ensureSession(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.send(req)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+		return resp, err
+	}
+
+	// The session expired, ex. qBittorrent restarted.
+	c.endSession()
+	err = c.ensureSession(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	// http.Client adds the jar's cookies to req itself, the expired session cookie must not be sent again.
+	req.Header.Del("Cookie")
+	if req.GetBody != nil {
+		req.Body, err = req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c.send(req)
+}
+
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	if !c.breaker.allow() {
+		return nil, fmt.Errorf("%s: %w", c.BasePath.String(), ErrServerUnavailable)
+	}
+
+	c.attachAuthHeader(req)
+
+	attempts := 1
+	if isIdempotent(req) {
+		attempts += c.transport.maxRetries
+	}
+
+	var resp *http.Response
+	var err error
+	for attempt := 1; ; attempt++ {
+		resp, err = c.httpClient.Do(req)
+		if attempt >= attempts || !shouldRetry(resp, err) || req.Context().Err() != nil {
+			break
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxErrorBodySize))
+			resp.Body.Close()
+		}
+
+		slog.Debug("Retrying request", "url", req.URL.String(), "attempt", attempt, "error", err)
+		errL := sleepContext(req.Context(), retryDelay(c.transport.retryBackoff, attempt))
+		if errL != nil {
+			c.breaker.abandon()
+			return nil, errL
+		}
+	}
+
+	if err != nil {
+		if req.Context().Err() != nil {
+			c.breaker.abandon()
+		} else {
+			c.recordFailure(err)
+		}
+		return nil, err
+	}
+
+	if resp.StatusCode >= 500 {
+		c.recordFailure(errors.New(resp.Status))
+	} else {
+		c.breaker.success()
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Endpoint:   req.URL.Path,
+			Body:       strings.TrimSpace(string(body)),
+		}
+	}
+
+	return resp, nil
+}
+
+func (c *Client) recordFailure(err error) {
+	if c.breaker.failure() {
+		slog.Warn("qBittorrent server marked unavailable", "url", c.BasePath.String(),
+			"cooldown", c.transport.breakerCooldown, "error", err)
+	}
+}
+
+// get sends a GET request to endpoint and returns the body.
+func (c *Client) get(ctx context.Context, endpoint string, query url.Values) ([]byte, error) {
+	currUrl := c.BasePath.JoinPath(endpoint)
+	currUrl.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, currUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return io.ReadAll(resp.Body)
+}
+
+// getJSON sends a GET request to endpoint and unmarshals the body into out.
+func (c *Client) getJSON(ctx context.Context, endpoint string, query url.Values, out any) error {
+	body, err := c.get(ctx, endpoint, query)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(body, out)
+	if err != nil {
+		return fmt.Errorf("%s: %w", endpoint, err)
+	}
+	return nil
+}
+
+// postForm sends form url encoded to endpoint and discards the body.
+func (c *Client) postForm(ctx context.Context, endpoint string, form url.Values) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BasePath.JoinPath(endpoint).String(),
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=UTF-8")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
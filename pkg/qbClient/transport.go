@@ -0,0 +1,180 @@
+package qbClient
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+)
+
+const (
+	defaultConnectTimeout   = 5 * time.Second
+	defaultReadTimeout      = 30 * time.Second
+	defaultMaxRetries       = 2
+	defaultRetryBackoff     = 250 * time.Millisecond
+	maxRetryBackoff         = 5 * time.Second
+	defaultBreakerThreshold = 5
+	defaultBreakerCooldown  = 30 * time.Second
+)
+
+// transportSettings is configuration.Transport with the defaults filled in.
+type transportSettings struct {
+	connectTimeout   time.Duration
+	readTimeout      time.Duration
+	maxRetries       int
+	retryBackoff     time.Duration
+	breakerThreshold int
+	breakerCooldown  time.Duration
+	// proxy is nil to use the proxy from the environment.
+	proxy     *url.URL
+	tlsConfig *tls.Config
+}
+
+func newTransportSettings(cfg configuration.Transport) (transportSettings, error) {
+	rtnMe := transportSettings{
+		connectTimeout:   durationOrDefault(cfg.ConnectTimeout, defaultConnectTimeout),
+		readTimeout:      durationOrDefault(cfg.ReadTimeout, defaultReadTimeout),
+		maxRetries:       countOrDefault(cfg.MaxRetries, defaultMaxRetries),
+		retryBackoff:     durationOrDefault(cfg.RetryBackoff, defaultRetryBackoff),
+		breakerThreshold: countOrDefault(cfg.BreakerThreshold, defaultBreakerThreshold),
+		breakerCooldown:  durationOrDefault(cfg.BreakerCooldown, defaultBreakerCooldown),
+	}
+
+	if cfg.Proxy != "" {
+		proxy, err := url.Parse(cfg.Proxy)
+		if err != nil {
+			return rtnMe, fmt.Errorf("invalid proxy: %w", err)
+		}
+		rtnMe.proxy = proxy
+	}
+
+	tlsConfig, err := newTlsConfig(cfg.TLS)
+	if err != nil {
+		return rtnMe, err
+	}
+	rtnMe.tlsConfig = tlsConfig
+
+	return rtnMe, nil
+}
+
+// newTlsConfig returns nil when cfg is empty so the default TLS config is used.
+func newTlsConfig(cfg configuration.TLS) (*tls.Config, error) {
+	if cfg == (configuration.TLS{}) {
+		return nil, nil
+	}
+
+	rtnMe := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	if cfg.CaFile != "" {
+		pem, err := os.ReadFile(cfg.CaFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading CA file: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", cfg.CaFile)
+		}
+		rtnMe.RootCAs = pool
+	}
+
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate: %w", err)
+		}
+		rtnMe.Certificates = []tls.Certificate{cert}
+	}
+
+	return rtnMe, nil
+}
+
+func durationOrDefault(d configuration.Duration, def time.Duration) time.Duration {
+	if d <= 0 {
+		return def
+	}
+	return d.Duration()
+}
+
+// countOrDefault returns def for 0, and 0 for negative values so -1 turns the feature off.
+func countOrDefault(n int, def int) int {
+	if n == 0 {
+		return def
+	}
+	return max(n, 0)
+}
+
+// newHttpClient builds the client for a single endpoint, with its own transport so one hung server can't exhaust
+// the connections of the others. withCookies keeps the session cookie of a password login. There's no overall
+// timeout so large responses, like exported .torrent files, can still stream.
+func newHttpClient(settings transportSettings, withCookies bool) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = (&net.Dialer{
+		Timeout:   settings.connectTimeout,
+		KeepAlive: 30 * time.Second,
+	}).DialContext
+	transport.TLSHandshakeTimeout = settings.connectTimeout
+	transport.ResponseHeaderTimeout = settings.readTimeout
+
+	if settings.proxy != nil {
+		transport.Proxy = http.ProxyURL(settings.proxy)
+	}
+	if settings.tlsConfig != nil {
+		transport.TLSClientConfig = settings.tlsConfig
+	}
+
+	rtnMe := &http.Client{Transport: transport}
+	if withCookies {
+		rtnMe.Jar, _ = cookiejar.New(nil) // only fails when given options with a broken public suffix list
+	}
+	return rtnMe
+}
+
+// isIdempotent reports whether req can be sent again without side effects.
+func isIdempotent(req *http.Request) bool {
+	return req.Method == http.MethodGet || req.Method == http.MethodHead
+}
+
+// shouldRetry reports whether the response or error is worth another attempt.
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// retryDelay returns the backoff for attempt, which starts at 1. Half of it is random so clients that failed
+// together don't retry together.
+func retryDelay(base time.Duration, attempt int) time.Duration {
+	backoff := min(base<<(attempt-1), maxRetryBackoff)
+	half := backoff / 2
+	return half + rand.N(half+1)
+}
+
+// sleepContext waits for d, returning early with the context error if ctx is done first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,96 @@
+package qbFake
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+	"gopkg.in/yaml.v3"
+)
+
+// WriteConfig writes a config file with the endpoints, for tests that go through configuration.InitConfig
+// and the client registry.
+func WriteConfig(path string, endpoints ...configuration.QbLogin) error {
+	return writeConfig(path, nil, endpoints)
+}
+
+// TestConfig is the config Main loads before a package's tests.
+type TestConfig struct {
+	Endpoints []configuration.QbLogin
+	// Settings are added to the top level of the config file. Ex. "auth"
+	Settings map[string]any
+	// Setup, when set, runs after the config is loaded and before the tests, with the config file's path.
+	Setup func(configFile string) error
+}
+
+// Main is the TestMain of a package whose tests share the config and the client registry. It writes config to a
+// temporary file, loads it with configuration.InitConfig, runs the tests, closes servers and exits.
+func Main(m *testing.M, config TestConfig, servers ...*Server) {
+	code, err := runMain(m, config)
+	for _, server := range servers {
+		server.Close()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Exit(code)
+}
+
+func runMain(m *testing.M, config TestConfig) (int, error) {
+	dir, err := os.MkdirTemp("", "qbFake")
+	if err != nil {
+		return 0, err
+	}
+	defer os.RemoveAll(dir)
+
+	configFile := filepath.Join(dir, "config.yaml")
+	if err = writeConfig(configFile, config.Settings, config.Endpoints); err != nil {
+		return 0, err
+	}
+	if _, err = configuration.InitConfig(configFile); err != nil {
+		return 0, err
+	}
+
+	if config.Setup != nil {
+		if err = config.Setup(configFile); err != nil {
+			return 0, err
+		}
+	}
+
+	return m.Run(), nil
+}
+
+// writeConfig writes a config file with the endpoints and settings.
+func writeConfig(path string, settings map[string]any, endpoints []configuration.QbLogin) error {
+	yamlEndpoints := make([]map[string]any, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		yamlEndpoints = append(yamlEndpoints, map[string]any{
+			"name":     endpoint.Name,
+			"group":    endpoint.Group,
+			"path":     endpoint.Path,
+			"apiKey":   endpoint.ApiKey.Value(),
+			"username": endpoint.Username,
+			"password": endpoint.Password.Value(),
+			"transport": map[string]any{
+				"retryBackoff": endpoint.Transport.RetryBackoff.Duration().String(),
+			},
+		})
+	}
+
+	values := map[string]any{
+		"env":       "development",
+		"endpoints": yamlEndpoints,
+	}
+	maps.Copy(values, settings)
+
+	content, err := yaml.Marshal(values)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0o600)
+}
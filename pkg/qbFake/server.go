@@ -0,0 +1,301 @@
+// Package qbFake is an in-process qBittorrent WebAPI for tests. It keeps torrents, categories, tags and
+// preferences in memory, supports api key and password auth, and can inject latency and errors per endpoint.
+package qbFake
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+)
+
+const (
+	// DefaultVersion is the WebAPI version of qBittorrent 5, which has /torrents/stop and /torrents/start.
+	DefaultVersion = "2.11.2"
+	// LegacyVersion is the WebAPI version of qBittorrent 4.6, which has /torrents/pause and /torrents/resume.
+	LegacyVersion = "2.9.3"
+)
+
+// Fault changes how the server answers requests to an endpoint.
+type Fault struct {
+	// Latency is added before the request is handled.
+	Latency time.Duration
+	// Status is sent instead of handling the request. Ex. http.StatusForbidden
+	Status int
+	// MalformedJSON sends a 200 with a body that isn't valid JSON.
+	MalformedJSON bool
+	// Times is how many requests the fault applies to, 0 applies it until ClearFaults.
+	Times int
+}
+
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	version  string
+	apiKey   string
+	username string
+	password string
+	sessions map[string]bool
+
+	torrents        []*Torrent
+	categories      map[string]Category
+	tags            map[string]bool
+	preferences     map[string]any
+	queueingEnabled bool
+
+	// rid is bumped on every change, see sync.go
+	rid               int
+	torrentsRemoved   map[string]int
+	categoriesRemoved map[string]int
+	tagsRemoved       map[string]int
+	categoriesChanged map[string]int
+	tagsChanged       map[string]int
+
+	faults   map[string]*Fault
+	requests map[string]int
+}
+
+type Option func(*Server)
+
+// WithVersion sets the WebAPI version the server reports and the endpoints it has. Ex. LegacyVersion
+func WithVersion(version string) Option {
+	return func(s *Server) {
+		s.version = version
+	}
+}
+
+// WithApiKey makes the server require the api key as a Bearer token.
+func WithApiKey(apiKey string) Option {
+	return func(s *Server) {
+		s.apiKey = apiKey
+	}
+}
+
+// WithLogin makes the server require a session from /auth/login with the username and password.
+func WithLogin(username, password string) Option {
+	return func(s *Server) {
+		s.username = username
+		s.password = password
+	}
+}
+
+// WithTorrents adds the torrents to the server, in the order given.
+func WithTorrents(torrents ...Torrent) Option {
+	return func(s *Server) {
+		for _, torrent := range torrents {
+			s.addTorrent(torrent)
+		}
+	}
+}
+
+// WithQueueing turns torrent queueing on or off, the queue endpoints fail with 409 while it's off.
+func WithQueueing(enabled bool) Option {
+	return func(s *Server) {
+		s.queueingEnabled = enabled
+	}
+}
+
+// New starts a server, close it with Close.
+func New(options ...Option) *Server {
+	rtnMe := &Server{
+		version:           DefaultVersion,
+		sessions:          make(map[string]bool),
+		categories:        make(map[string]Category),
+		tags:              make(map[string]bool),
+		preferences:       defaultPreferences(),
+		queueingEnabled:   true,
+		torrentsRemoved:   make(map[string]int),
+		categoriesRemoved: make(map[string]int),
+		tagsRemoved:       make(map[string]int),
+		categoriesChanged: make(map[string]int),
+		tagsChanged:       make(map[string]int),
+		faults:            make(map[string]*Fault),
+		requests:          make(map[string]int),
+	}
+
+	for _, option := range options {
+		option(rtnMe)
+	}
+	rtnMe.preferences["queueing_enabled"] = rtnMe.queueingEnabled
+
+	rtnMe.Server = httptest.NewServer(http.HandlerFunc(rtnMe.serveHTTP))
+	return rtnMe
+}
+
+// Login returns an endpoint config for the server with its credentials. Retries are fast so tests don't wait.
+func (s *Server) Login(name string) configuration.QbLogin {
+	return configuration.QbLogin{
+		Name:     name,
+		Path:     s.URL,
+		ApiKey:   configuration.Secret(s.apiKey),
+		Username: s.username,
+		Password: configuration.Secret(s.password),
+		Transport: configuration.Transport{
+			RetryBackoff: configuration.Duration(time.Millisecond),
+		},
+	}
+}
+
+// InjectFault applies fault to requests to endpoint, ex. /api/v2/torrents/info, or to every endpoint when it's "".
+func (s *Server) InjectFault(endpoint string, fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults[endpoint] = &fault
+}
+
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = make(map[string]*Fault)
+}
+
+// Requests returns how many requests were sent to endpoint, including ones answered by a fault.
+func (s *Server) Requests(endpoint string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests[endpoint]
+}
+
+// ExpireSessions logs out every password session, like a qBittorrent restart.
+func (s *Server) ExpireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sessions = make(map[string]bool)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	endpoint := r.URL.Path
+
+	s.mu.Lock()
+	s.requests[endpoint]++
+	fault := s.takeFault(endpoint)
+	s.mu.Unlock()
+
+	if fault != nil {
+		if fault.Latency > 0 {
+			select {
+			case <-time.After(fault.Latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if fault.Status != 0 {
+			http.Error(w, http.StatusText(fault.Status), fault.Status)
+			return
+		}
+		if fault.MalformedJSON {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"truncated": [`))
+			return
+		}
+	}
+
+	handler, exist := s.routes()[endpoint]
+	if !exist {
+		http.NotFound(w, r)
+		return
+	}
+
+	if endpoint != "/api/v2/auth/login" && !s.authorized(r) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	handler(w, r)
+}
+
+// takeFault returns the fault for endpoint, or the one for every endpoint. s.mu must be held.
+func (s *Server) takeFault(endpoint string) *Fault {
+	for _, key := range []string{endpoint, ""} {
+		fault, exist := s.faults[key]
+		if !exist {
+			continue
+		}
+
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				delete(s.faults, key)
+			}
+		}
+		return fault
+	}
+	return nil
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	if s.apiKey != "" {
+		return r.Header.Get("Authorization") == "Bearer "+s.apiKey
+	}
+
+	if s.username != "" {
+		cookie, err := r.Cookie("SID")
+		if err != nil {
+			return false
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.sessions[cookie.Value]
+	}
+
+	return true
+}
+
+func (s *Server) login(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// qBittorrent answers a wrong password with 200 and Fails.
+	if s.username == "" || r.FormValue("username") != s.username || r.FormValue("password") != s.password {
+		_, _ = w.Write([]byte("Fails."))
+		return
+	}
+
+	sid := make([]byte, 16)
+	_, _ = rand.Read(sid)
+	session := hex.EncodeToString(sid)
+	s.sessions[session] = true
+
+	http.SetCookie(w, &http.Cookie{Name: "SID", Value: session, Path: "/"})
+	_, _ = w.Write([]byte("Ok."))
+}
+
+// hasVersion reports whether the server's WebAPI version is at least min.
+func (s *Server) hasVersion(min string) bool {
+	return compareVersions(s.version, min) >= 0
+}
+
+func compareVersions(a, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+
+	for i := 0; i < max(len(aParts), len(bParts)); i++ {
+		var aPart, bPart int
+		if i < len(aParts) {
+			aPart = atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bPart = atoi(bParts[i])
+		}
+		if aPart != bPart {
+			return aPart - bPart
+		}
+	}
+	return 0
+}
@@ -0,0 +1,100 @@
+package qbFake
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+)
+
+// Every change bumps rid, and remembers the rid it was made at, so /sync/maindata can answer with only what
+// changed since the rid the caller last saw.
+
+// bump starts a new revision. s.mu must be held.
+func (s *Server) bump() {
+	s.rid++
+}
+
+// touch marks the torrent as changed in a new revision. s.mu must be held.
+func (s *Server) touch(torrent *Torrent) {
+	s.bump()
+	torrent.modifiedRid = s.rid
+}
+
+type mainData struct {
+	Rid               int                 `json:"rid"`
+	FullUpdate        bool                `json:"full_update,omitempty"`
+	Torrents          map[string]Torrent  `json:"torrents,omitempty"`
+	TorrentsRemoved   []string            `json:"torrents_removed,omitempty"`
+	Categories        map[string]Category `json:"categories,omitempty"`
+	CategoriesRemoved []string            `json:"categories_removed,omitempty"`
+	Tags              []string            `json:"tags,omitempty"`
+	TagsRemoved       []string            `json:"tags_removed,omitempty"`
+	ServerState       map[string]any      `json:"server_state,omitempty"`
+}
+
+// mainData answers /sync/maindata. A rid of 0, or one the server never sent, gets a full update.
+// Changed torrents are sent whole, qBittorrent only sends the changed fields but a full object is still valid.
+func (s *Server) mainData(w http.ResponseWriter, r *http.Request) {
+	rid, _ := strconv.Atoi(r.URL.Query().Get("rid"))
+
+	rtnMe := mainData{
+		Rid:        s.rid,
+		Torrents:   make(map[string]Torrent),
+		Categories: make(map[string]Category),
+	}
+
+	if rid <= 0 || rid > s.rid {
+		rtnMe.FullUpdate = true
+		for _, torrent := range s.torrents {
+			rtnMe.Torrents[torrent.Hash] = *torrent
+		}
+		rtnMe.Categories = maps.Clone(s.categories)
+		rtnMe.Tags = slices.Sorted(maps.Keys(s.tags))
+		rtnMe.ServerState = s.serverState()
+
+		writeJSON(w, rtnMe)
+		return
+	}
+
+	for _, torrent := range s.torrents {
+		if torrent.modifiedRid > rid {
+			rtnMe.Torrents[torrent.Hash] = *torrent
+		}
+	}
+	rtnMe.TorrentsRemoved = changedSince(s.torrentsRemoved, rid)
+	for _, name := range changedSince(s.categoriesChanged, rid) {
+		rtnMe.Categories[name] = s.categories[name]
+	}
+	rtnMe.CategoriesRemoved = changedSince(s.categoriesRemoved, rid)
+	rtnMe.Tags = changedSince(s.tagsChanged, rid)
+	rtnMe.TagsRemoved = changedSince(s.tagsRemoved, rid)
+
+	writeJSON(w, rtnMe)
+}
+
+func changedSince(changes map[string]int, rid int) []string {
+	rtnMe := make([]string, 0)
+	for key, changedAt := range changes {
+		if changedAt > rid {
+			rtnMe = append(rtnMe, key)
+		}
+	}
+	slices.Sort(rtnMe)
+	return rtnMe
+}
+
+func (s *Server) serverState() map[string]any {
+	var dlSpeed, upSpeed int
+	for _, torrent := range s.torrents {
+		dlSpeed += torrent.Dlspeed
+		upSpeed += torrent.Upspeed
+	}
+
+	return map[string]any{
+		"connection_status": "connected",
+		"dl_info_speed":     dlSpeed,
+		"up_info_speed":     upSpeed,
+		"queueing":          s.queueingEnabled,
+	}
+}
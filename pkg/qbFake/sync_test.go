@@ -0,0 +1,97 @@
+package qbFake
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func getMainData(t *testing.T, server *Server, rid int) mainData {
+	t.Helper()
+
+	resp, err := http.Get(server.URL + "/api/v2/sync/maindata?rid=" + strconv.Itoa(rid))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var rtnMe mainData
+	if err = json.NewDecoder(resp.Body).Decode(&rtnMe); err != nil {
+		t.Fatal(err)
+	}
+	return rtnMe
+}
+
+func postForm(t *testing.T, server *Server, endpoint string, form url.Values) {
+	t.Helper()
+
+	resp, err := http.Post(server.URL+endpoint, "application/x-www-form-urlencoded",
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("%s: %s", endpoint, resp.Status)
+	}
+}
+
+func TestMainDataDeltas(t *testing.T) {
+	server := New(WithTorrents(Torrent{Name: "a"}, Torrent{Name: "b"}, Torrent{Name: "c", Category: "linux"}))
+	defer server.Close()
+	torrents := server.Torrents()
+
+	full := getMainData(t, server, 0)
+	if !full.FullUpdate || len(full.Torrents) != 3 || len(full.Categories) != 1 {
+		t.Fatalf("got %+v, want a full update", full)
+	}
+
+	postForm(t, server, "/api/v2/torrents/stop", url.Values{"hashes": {torrents[0].Hash}})
+	postForm(t, server, "/api/v2/torrents/delete", url.Values{"hashes": {torrents[1].Hash}})
+	postForm(t, server, "/api/v2/torrents/removeCategories", url.Values{"categories": {"linux"}})
+
+	delta := getMainData(t, server, full.Rid)
+	if delta.FullUpdate {
+		t.Fatal("got a full update, want a delta")
+	}
+
+	changed := slices.Sorted(maps.Keys(delta.Torrents))
+	// c lost its category, so it changed too
+	want := []string{torrents[0].Hash, torrents[2].Hash}
+	slices.Sort(want)
+	if !slices.Equal(changed, want) {
+		t.Errorf("changed torrents = %v, want %v", changed, want)
+	}
+	if delta.Torrents[torrents[0].Hash].State != "stoppedUP" {
+		t.Errorf("state = %s, want stoppedUP", delta.Torrents[torrents[0].Hash].State)
+	}
+	if !slices.Equal(delta.TorrentsRemoved, []string{torrents[1].Hash}) {
+		t.Errorf("removed torrents = %v", delta.TorrentsRemoved)
+	}
+	if !slices.Equal(delta.CategoriesRemoved, []string{"linux"}) {
+		t.Errorf("removed categories = %v", delta.CategoriesRemoved)
+	}
+
+	empty := getMainData(t, server, delta.Rid)
+	if len(empty.Torrents) != 0 || len(empty.TorrentsRemoved) != 0 || empty.Rid != delta.Rid {
+		t.Errorf("got %+v, want nothing changed", empty)
+	}
+}
+
+func TestTags(t *testing.T) {
+	server := New(WithTorrents(Torrent{Name: "a", Tags: "old"}))
+	defer server.Close()
+	hash := server.Torrents()[0].Hash
+
+	postForm(t, server, "/api/v2/torrents/addTags", url.Values{"hashes": {hash}, "tags": {"new,keep"}})
+	postForm(t, server, "/api/v2/torrents/removeTags", url.Values{"hashes": {hash}, "tags": {"old"}})
+
+	if torrent, _ := server.Torrent(hash); torrent.Tags != "keep, new" {
+		t.Errorf("tags = %q, want %q", torrent.Tags, "keep, new")
+	}
+}
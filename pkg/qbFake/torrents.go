@@ -0,0 +1,682 @@
+package qbFake
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbClient"
+)
+
+// Torrent is a torrent as the WebAPI returns it. Fields left empty get a default when the torrent is added.
+type Torrent struct {
+	Hash         string  `json:"hash"`
+	InfohashV1   string  `json:"infohash_v1"`
+	Name         string  `json:"name"`
+	Category     string  `json:"category"`
+	Tags         string  `json:"tags"`
+	State        string  `json:"state"`
+	Size         int64   `json:"size"`
+	TotalSize    int64   `json:"total_size"`
+	Progress     float64 `json:"progress"`
+	Priority     int     `json:"priority"`
+	AddedOn      int64   `json:"added_on"`
+	CompletionOn int64   `json:"completion_on"`
+	SavePath     string  `json:"save_path"`
+	Ratio        float64 `json:"ratio"`
+	Dlspeed      int     `json:"dlspeed"`
+	Upspeed      int     `json:"upspeed"`
+	Downloaded   int64   `json:"downloaded"`
+	Uploaded     int64   `json:"uploaded"`
+	NumSeeds     int     `json:"num_seeds"`
+	NumLeechs    int     `json:"num_leechs"`
+	Tracker      string  `json:"tracker"`
+
+	DlLimit                  int64   `json:"dl_limit"`
+	UpLimit                  int64   `json:"up_limit"`
+	RatioLimit               float64 `json:"ratio_limit"`
+	SeedingTimeLimit         int     `json:"seeding_time_limit"`
+	InactiveSeedingTimeLimit int     `json:"inactive_seeding_time_limit"`
+
+	Trackers []qbClient.TorrentTracker `json:"-"`
+	Files    []qbClient.TorrentFile    `json:"-"`
+	// Content is the .torrent file returned by /torrents/export.
+	Content []byte `json:"-"`
+
+	modifiedRid int
+}
+
+type Category struct {
+	Name     string `json:"name"`
+	SavePath string `json:"savePath"`
+}
+
+// firstAddedOn makes added_on predictable, each torrent is added a minute after the previous one.
+var firstAddedOn = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func (s *Server) routes() map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		"/api/v2/auth/login":                s.login,
+		"/api/v2/app/webapiVersion":         s.webapiVersion,
+		"/api/v2/app/preferences":           s.getPreferences,
+		"/api/v2/app/setPreferences":        post(s.setPreferences),
+		"/api/v2/sync/maindata":             s.mainData,
+		"/api/v2/torrents/info":             s.info,
+		"/api/v2/torrents/trackers":         s.trackers,
+		"/api/v2/torrents/files":            s.files,
+		"/api/v2/torrents/export":           s.export,
+		"/api/v2/torrents/add":              post(s.add),
+		"/api/v2/torrents/delete":           post(s.delete),
+		"/api/v2/torrents/categories":       s.getCategories,
+		"/api/v2/torrents/createCategory":   post(s.createCategory),
+		"/api/v2/torrents/removeCategories": post(s.removeCategories),
+		"/api/v2/torrents/setCategory":      post(s.setCategory),
+		"/api/v2/torrents/tags":             s.getTags,
+		"/api/v2/torrents/createTags":       post(s.createTags),
+		"/api/v2/torrents/deleteTags":       post(s.deleteTags),
+		"/api/v2/torrents/addTags":          post(s.addTags),
+		"/api/v2/torrents/removeTags":       post(s.removeTags),
+		"/api/v2/torrents/recheck":          post(s.setState("checkingUP")),
+		"/api/v2/torrents/setDownloadLimit": post(s.setLimits),
+		"/api/v2/torrents/setUploadLimit":   post(s.setLimits),
+		"/api/v2/torrents/setShareLimits":   post(s.setShareLimits),
+		"/api/v2/torrents/setLocation":      post(s.setLocation),
+		"/api/v2/torrents/pause":            s.sinceBefore("", "2.11.0", post(s.setState("pausedUP"))),
+		"/api/v2/torrents/resume":           s.sinceBefore("", "2.11.0", post(s.setState("stalledUP"))),
+		"/api/v2/torrents/stop":             s.sinceBefore("2.11.0", "", post(s.setState("stoppedUP"))),
+		"/api/v2/torrents/start":            s.sinceBefore("2.11.0", "", post(s.setState("stalledUP"))),
+		"/api/v2/torrents/increasePrio":     post(s.moveInQueue(queueUp)),
+		"/api/v2/torrents/decreasePrio":     post(s.moveInQueue(queueDown)),
+		"/api/v2/torrents/topPrio":          post(s.moveInQueue(queueTop)),
+		"/api/v2/torrents/bottomPrio":       post(s.moveInQueue(queueBottom)),
+	}
+}
+
+func post(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// sinceBefore answers 404 unless the WebAPI version is at least since and below before, empty means no bound.
+func (s *Server) sinceBefore(since, before string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if (since != "" && !s.hasVersion(since)) || (before != "" && s.hasVersion(before)) {
+			http.NotFound(w, r)
+			return
+		}
+		handler(w, r)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// AddTorrent adds a torrent to the server, like a torrent added from outside the panel.
+func (s *Server) AddTorrent(torrent Torrent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.addTorrent(torrent)
+}
+
+// addTorrent fills in the defaults and adds the torrent. s.mu must be held, or the server not started yet.
+func (s *Server) addTorrent(torrent Torrent) *Torrent {
+	if torrent.Hash == "" {
+		sum := sha1.Sum([]byte(torrent.Name))
+		torrent.Hash = hex.EncodeToString(sum[:])
+	}
+	if torrent.InfohashV1 == "" {
+		torrent.InfohashV1 = torrent.Hash
+	}
+	if torrent.State == "" {
+		torrent.State = "stalledUP"
+	}
+	if torrent.AddedOn == 0 {
+		torrent.AddedOn = firstAddedOn.Add(time.Duration(len(s.torrents)) * time.Minute).Unix()
+	}
+	if torrent.TotalSize == 0 {
+		torrent.TotalSize = torrent.Size
+	}
+	if len(torrent.Content) == 0 {
+		torrent.Content = []byte("d4:infod4:name" + strconv.Itoa(len(torrent.Name)) + ":" + torrent.Name + "ee")
+	}
+	if s.queueingEnabled && torrent.Priority == 0 {
+		torrent.Priority = len(s.torrents) + 1
+	}
+	if torrent.Category != "" {
+		if _, exist := s.categories[torrent.Category]; !exist {
+			s.categories[torrent.Category] = Category{Name: torrent.Category}
+			s.bump()
+			s.categoriesChanged[torrent.Category] = s.rid
+		}
+	}
+	for _, tag := range splitList(torrent.Tags, ",") {
+		s.tags[tag] = true
+	}
+
+	rtnMe := &torrent
+	s.torrents = append(s.torrents, rtnMe)
+	delete(s.torrentsRemoved, torrent.Hash)
+	s.touch(rtnMe)
+	return rtnMe
+}
+
+// Torrent returns a copy of the torrent with the hash, for assertions.
+func (s *Server) Torrent(hash string) (Torrent, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, torrent := range s.torrents {
+		if torrent.Hash == hash {
+			return *torrent, true
+		}
+	}
+	return Torrent{}, false
+}
+
+// HashOf returns the hash of the first torrent called name, "" when there isn't one.
+func (s *Server) HashOf(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, torrent := range s.torrents {
+		if torrent.Name == name {
+			return torrent.Hash
+		}
+	}
+	return ""
+}
+
+// Torrents returns a copy of every torrent in the order they were added.
+func (s *Server) Torrents() []Torrent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rtnMe := make([]Torrent, 0, len(s.torrents))
+	for _, torrent := range s.torrents {
+		rtnMe = append(rtnMe, *torrent)
+	}
+	return rtnMe
+}
+
+func (s *Server) Categories() map[string]Category {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return maps.Clone(s.categories)
+}
+
+func (s *Server) Preferences() map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return maps.Clone(s.preferences)
+}
+
+// selectTorrents returns the torrents in the hashes form value, | separated or all.
+func (s *Server) selectTorrents(hashes string) []*Torrent {
+	if hashes == "all" {
+		return slices.Clone(s.torrents)
+	}
+
+	wanted := splitList(hashes, "|")
+	rtnMe := make([]*Torrent, 0, len(wanted))
+	for _, torrent := range s.torrents {
+		if slices.Contains(wanted, torrent.Hash) {
+			rtnMe = append(rtnMe, torrent)
+		}
+	}
+	return rtnMe
+}
+
+func (s *Server) findTorrent(hash string) *Torrent {
+	for _, torrent := range s.torrents {
+		if torrent.Hash == hash {
+			return torrent
+		}
+	}
+	return nil
+}
+
+func (s *Server) webapiVersion(w http.ResponseWriter, r *http.Request) {
+	_, _ = w.Write([]byte(s.version))
+}
+
+// info supports the hashes, category, tag, sort and reverse parameters.
+func (s *Server) info(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	torrents := s.torrents
+	if query.Has("hashes") {
+		torrents = s.selectTorrents(query.Get("hashes"))
+	}
+
+	rtnMe := make([]Torrent, 0, len(torrents))
+	for _, torrent := range torrents {
+		if query.Has("category") && torrent.Category != query.Get("category") {
+			continue
+		}
+		if query.Has("tag") && !slices.Contains(splitList(torrent.Tags, ","), query.Get("tag")) {
+			continue
+		}
+		rtnMe = append(rtnMe, *torrent)
+	}
+
+	switch query.Get("sort") {
+	case "name":
+		slices.SortStableFunc(rtnMe, func(a, b Torrent) int { return strings.Compare(a.Name, b.Name) })
+	case "priority":
+		slices.SortStableFunc(rtnMe, func(a, b Torrent) int { return a.Priority - b.Priority })
+	case "size":
+		slices.SortStableFunc(rtnMe, func(a, b Torrent) int { return int(a.Size - b.Size) })
+	default: // added_on, torrents are kept in the order they were added
+	}
+	if query.Get("reverse") == "true" {
+		slices.Reverse(rtnMe)
+	}
+
+	writeJSON(w, rtnMe)
+}
+
+func (s *Server) trackers(w http.ResponseWriter, r *http.Request) {
+	torrent := s.findTorrent(r.URL.Query().Get("hash"))
+	if torrent == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	writeJSON(w, nonNil(torrent.Trackers))
+}
+
+func (s *Server) files(w http.ResponseWriter, r *http.Request) {
+	torrent := s.findTorrent(r.URL.Query().Get("hash"))
+	if torrent == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	writeJSON(w, nonNil(torrent.Files))
+}
+
+func (s *Server) export(w http.ResponseWriter, r *http.Request) {
+	if !s.hasVersion("2.8.14") {
+		http.NotFound(w, r)
+		return
+	}
+
+	torrent := s.findTorrent(r.URL.Query().Get("hash"))
+	if torrent == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-bittorrent")
+	_, _ = w.Write(torrent.Content)
+}
+
+// add takes .torrent files in the torrents field. The hash is the sha1 of the file, not of its info dict.
+func (s *Server) add(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseMultipartForm(32 << 20)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	files := r.MultipartForm.File["torrents"]
+	if len(files) == 0 {
+		http.Error(w, "Fails.", http.StatusBadRequest)
+		return
+	}
+
+	for _, header := range files {
+		file, errL := header.Open()
+		if errL != nil {
+			http.Error(w, errL.Error(), http.StatusBadRequest)
+			return
+		}
+		content, errL := io.ReadAll(file)
+		file.Close()
+		if errL != nil {
+			http.Error(w, errL.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Every .torrent file is a bencoded dictionary.
+		if len(content) == 0 || content[0] != 'd' {
+			http.Error(w, "Torrent file is not valid", http.StatusUnsupportedMediaType)
+			return
+		}
+
+		sum := sha1.Sum(content)
+		s.addTorrent(Torrent{
+			Hash:     hex.EncodeToString(sum[:]),
+			Name:     strings.TrimSuffix(header.Filename, ".torrent"),
+			Category: r.FormValue("category"),
+			Tags:     r.FormValue("tags"),
+			State:    "downloading",
+			Content:  content,
+		})
+	}
+
+	_, _ = w.Write([]byte("Ok."))
+}
+
+func (s *Server) delete(w http.ResponseWriter, r *http.Request) {
+	for _, torrent := range s.selectTorrents(r.FormValue("hashes")) {
+		s.torrents = slices.DeleteFunc(s.torrents, func(t *Torrent) bool { return t == torrent })
+		s.bump()
+		s.torrentsRemoved[torrent.Hash] = s.rid
+	}
+}
+
+func (s *Server) setState(state string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		for _, torrent := range s.selectTorrents(r.FormValue("hashes")) {
+			torrent.State = state
+			s.touch(torrent)
+		}
+	}
+}
+
+func (s *Server) getCategories(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, s.categories)
+}
+
+func (s *Server) createCategory(w http.ResponseWriter, r *http.Request) {
+	name := r.FormValue("category")
+	if name == "" {
+		http.Error(w, "Category name cannot be empty", http.StatusBadRequest)
+		return
+	}
+	if _, exist := s.categories[name]; exist || strings.ContainsAny(name, "\\") {
+		http.Error(w, "Category name is invalid", http.StatusConflict)
+		return
+	}
+
+	s.categories[name] = Category{Name: name, SavePath: r.FormValue("savePath")}
+	s.bump()
+	s.categoriesChanged[name] = s.rid
+	delete(s.categoriesRemoved, name)
+}
+
+func (s *Server) removeCategories(w http.ResponseWriter, r *http.Request) {
+	for _, name := range splitList(r.FormValue("categories"), "\n") {
+		if _, exist := s.categories[name]; !exist {
+			continue
+		}
+		delete(s.categories, name)
+		s.bump()
+		s.categoriesRemoved[name] = s.rid
+		delete(s.categoriesChanged, name)
+
+		for _, torrent := range s.torrents {
+			if torrent.Category == name {
+				torrent.Category = ""
+				s.touch(torrent)
+			}
+		}
+	}
+}
+
+func (s *Server) setCategory(w http.ResponseWriter, r *http.Request) {
+	name := r.FormValue("category")
+	if _, exist := s.categories[name]; !exist && name != "" {
+		http.Error(w, "Category does not exist", http.StatusConflict)
+		return
+	}
+
+	for _, torrent := range s.selectTorrents(r.FormValue("hashes")) {
+		torrent.Category = name
+		s.touch(torrent)
+	}
+}
+
+// setLimits serves both setDownloadLimit and setUploadLimit.
+func (s *Server) setLimits(w http.ResponseWriter, r *http.Request) {
+	limit, err := strconv.ParseInt(r.FormValue("limit"), 10, 64)
+	if err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	for _, torrent := range s.selectTorrents(r.FormValue("hashes")) {
+		if strings.HasSuffix(r.URL.Path, "setDownloadLimit") {
+			torrent.DlLimit = limit
+		} else {
+			torrent.UpLimit = limit
+		}
+		s.touch(torrent)
+	}
+}
+
+func (s *Server) setShareLimits(w http.ResponseWriter, r *http.Request) {
+	ratio, err := strconv.ParseFloat(r.FormValue("ratioLimit"), 64)
+	seeding, err2 := strconv.Atoi(r.FormValue("seedingTimeLimit"))
+	inactive, err3 := strconv.Atoi(r.FormValue("inactiveSeedingTimeLimit"))
+	if err != nil || err2 != nil || err3 != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	for _, torrent := range s.selectTorrents(r.FormValue("hashes")) {
+		torrent.RatioLimit = ratio
+		torrent.SeedingTimeLimit = seeding
+		torrent.InactiveSeedingTimeLimit = inactive
+		s.touch(torrent)
+	}
+}
+
+func (s *Server) setLocation(w http.ResponseWriter, r *http.Request) {
+	location := r.FormValue("location")
+	if location == "" {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	for _, torrent := range s.selectTorrents(r.FormValue("hashes")) {
+		torrent.SavePath = location
+		s.touch(torrent)
+	}
+}
+
+func (s *Server) getTags(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, slices.Sorted(maps.Keys(s.tags)))
+}
+
+func (s *Server) createTags(w http.ResponseWriter, r *http.Request) {
+	for _, tag := range splitList(r.FormValue("tags"), ",") {
+		s.tags[tag] = true
+		s.bump()
+		s.tagsChanged[tag] = s.rid
+		delete(s.tagsRemoved, tag)
+	}
+}
+
+func (s *Server) deleteTags(w http.ResponseWriter, r *http.Request) {
+	for _, tag := range splitList(r.FormValue("tags"), ",") {
+		delete(s.tags, tag)
+		s.bump()
+		s.tagsRemoved[tag] = s.rid
+		delete(s.tagsChanged, tag)
+
+		for _, torrent := range s.torrents {
+			s.setTorrentTags(torrent, slices.DeleteFunc(splitList(torrent.Tags, ","), func(t string) bool {
+				return t == tag
+			}))
+		}
+	}
+}
+
+func (s *Server) addTags(w http.ResponseWriter, r *http.Request) {
+	tags := splitList(r.FormValue("tags"), ",")
+	for _, tag := range tags {
+		if !s.tags[tag] {
+			s.tags[tag] = true
+			s.bump()
+			s.tagsChanged[tag] = s.rid
+		}
+	}
+
+	for _, torrent := range s.selectTorrents(r.FormValue("hashes")) {
+		current := splitList(torrent.Tags, ",")
+		for _, tag := range tags {
+			if !slices.Contains(current, tag) {
+				current = append(current, tag)
+			}
+		}
+		s.setTorrentTags(torrent, current)
+	}
+}
+
+func (s *Server) removeTags(w http.ResponseWriter, r *http.Request) {
+	tags := splitList(r.FormValue("tags"), ",")
+
+	for _, torrent := range s.selectTorrents(r.FormValue("hashes")) {
+		s.setTorrentTags(torrent, slices.DeleteFunc(splitList(torrent.Tags, ","), func(t string) bool {
+			return slices.Contains(tags, t)
+		}))
+	}
+}
+
+func (s *Server) setTorrentTags(torrent *Torrent, tags []string) {
+	slices.Sort(tags)
+	joined := strings.Join(tags, ", ")
+	if joined == torrent.Tags {
+		return
+	}
+	torrent.Tags = joined
+	s.touch(torrent)
+}
+
+type queueMove int
+
+const (
+	queueUp queueMove = iota
+	queueDown
+	queueTop
+	queueBottom
+)
+
+// moveInQueue reorders the queue like qBittorrent, priority 1 is the top. It's 409 when queueing is off.
+func (s *Server) moveInQueue(move queueMove) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !s.queueingEnabled {
+			http.Error(w, "Torrent queueing must be enabled", http.StatusConflict)
+			return
+		}
+
+		selected := s.selectTorrents(r.FormValue("hashes"))
+		queue := slices.Clone(s.torrents)
+		slices.SortStableFunc(queue, func(a, b *Torrent) int { return a.Priority - b.Priority })
+		isSelected := func(t *Torrent) bool { return slices.Contains(selected, t) }
+
+		switch move {
+		case queueUp:
+			for i := 1; i < len(queue); i++ {
+				if isSelected(queue[i]) && !isSelected(queue[i-1]) {
+					queue[i], queue[i-1] = queue[i-1], queue[i]
+				}
+			}
+		case queueDown:
+			for i := len(queue) - 2; i >= 0; i-- {
+				if isSelected(queue[i]) && !isSelected(queue[i+1]) {
+					queue[i], queue[i+1] = queue[i+1], queue[i]
+				}
+			}
+		case queueTop:
+			top, rest := partition(queue, isSelected)
+			queue = append(top, rest...)
+		case queueBottom:
+			bottom, rest := partition(queue, isSelected)
+			queue = append(rest, bottom...)
+		}
+
+		for i, torrent := range queue {
+			if torrent.Priority != i+1 {
+				torrent.Priority = i + 1
+				s.touch(torrent)
+			}
+		}
+	}
+}
+
+func partition(torrents []*Torrent, match func(*Torrent) bool) (matched []*Torrent, rest []*Torrent) {
+	for _, torrent := range torrents {
+		if match(torrent) {
+			matched = append(matched, torrent)
+		} else {
+			rest = append(rest, torrent)
+		}
+	}
+	return matched, rest
+}
+
+func (s *Server) getPreferences(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, s.preferences)
+}
+
+func (s *Server) setPreferences(w http.ResponseWriter, r *http.Request) {
+	var patch map[string]any
+	err := json.Unmarshal([]byte(r.FormValue("json")), &patch)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	maps.Copy(s.preferences, patch)
+	if queueing, ok := patch["queueing_enabled"].(bool); ok {
+		s.queueingEnabled = queueing
+	}
+}
+
+func defaultPreferences() map[string]any {
+	return map[string]any{
+		"save_path":            "/downloads",
+		"queueing_enabled":     true,
+		"max_active_downloads": 3,
+		"max_active_torrents":  5,
+		"max_active_uploads":   3,
+		"dht":                  true,
+		"pex":                  true,
+		"listen_port":          6881,
+		// a key the panel doesn't know about, to check it survives a round trip
+		"web_ui_custom_http_headers_enabled": false,
+	}
+}
+
+func splitList(value, sep string) []string {
+	rtnMe := make([]string, 0)
+	for _, part := range strings.Split(value, sep) {
+		part = strings.TrimSpace(part)
+		if part != "" {
+			rtnMe = append(rtnMe, part)
+		}
+	}
+	return rtnMe
+}
+
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
+
+func atoi(s string) int {
+	rtnMe, _ := strconv.Atoi(s)
+	return rtnMe
+}
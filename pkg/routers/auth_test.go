@@ -0,0 +1,164 @@
+package routers_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/auth"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/qbFake"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/routers"
+	"github.com/labstack/echo/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var fake *qbFake.Server
+
+// TestMain loads a config with a user, so every route needs a login.
+func TestMain(m *testing.M) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("hunter2"), bcrypt.MinCost)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	fake = qbFake.New(qbFake.WithTorrents(qbFake.Torrent{Name: "debian:13.iso"}))
+
+	qbFake.Main(m, qbFake.TestConfig{
+		Endpoints: []configuration.QbLogin{fake.Login("fake")},
+		Settings: map[string]any{
+			"auth": map[string]any{
+				"users": []map[string]any{{"name": "admin", "passwordHash": string(hash)}},
+			},
+		},
+	}, fake)
+}
+
+type gqlResponse struct {
+	Data   map[string]json.RawMessage
+	Errors []struct {
+		Message    string
+		Extensions map[string]any
+	}
+}
+
+// post sends query to /query with the cookies and CSRF token given.
+func post(t *testing.T, e *echo.Echo, query string, cookies []*http.Cookie, csrfToken string) (*httptest.ResponseRecorder, gqlResponse) {
+	t.Helper()
+
+	body, _ := json.Marshal(map[string]string{"query": query})
+	req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	if csrfToken != "" {
+		req.Header.Set(auth.CSRFHeader, csrfToken)
+	}
+	for _, cookie := range cookies {
+		req.AddCookie(cookie)
+	}
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	var resp gqlResponse
+	if rec.Code == http.StatusOK {
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return rec, resp
+}
+
+func (resp gqlResponse) code() string {
+	if len(resp.Errors) == 0 {
+		return ""
+	}
+	code, _ := resp.Errors[0].Extensions["code"].(string)
+	return code
+}
+
+func TestAuth(t *testing.T) {
+	e := routers.NewEchoHandler(routers.NewGraphqlHandler())
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+	if rec.Code == http.StatusUnauthorized {
+		t.Error("/health needs a login")
+	}
+
+	for _, path := range []string{"/health/servers", "/exportTorrent?server=fake&hash=abc"} {
+		rec = httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("%s without a login: status %d, want 401", path, rec.Code)
+		}
+	}
+
+	_, resp := post(t, e, `{ Torrents { Name } }`, nil, "")
+	if resp.code() != "UNAUTHENTICATED" {
+		t.Errorf("Torrents without a login: got %+v, want UNAUTHENTICATED", resp)
+	}
+
+	_, resp = post(t, e, `{ AuthStatus { Enabled User } }`, nil, "")
+	if got := string(resp.Data["AuthStatus"]); got != `{"Enabled":true,"User":null}` {
+		t.Errorf("AuthStatus = %s", got)
+	}
+
+	_, resp = post(t, e, `mutation { login(args: {Username: "admin", Password: "wrong"}) { User } }`, nil, "")
+	if resp.code() != "UNAUTHENTICATED" {
+		t.Errorf("wrong password: got %+v, want UNAUTHENTICATED", resp)
+	}
+
+	rec, resp = post(t, e, `mutation { login(args: {Username: "admin", Password: "hunter2"}) { User CsrfToken } }`, nil, "")
+	var login struct{ User, CsrfToken string }
+	if err := json.Unmarshal(resp.Data["login"], &login); err != nil || login.User != "admin" {
+		t.Fatalf("login: got %+v", resp)
+	}
+	cookies := rec.Result().Cookies()
+
+	rec, _ = post(t, e, `{ Torrents { Name } }`, cookies, "")
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("no CSRF token: status %d, want 403", rec.Code)
+	}
+
+	_, resp = post(t, e, `{ Torrents { Name } }`, cookies, login.CsrfToken)
+	if len(resp.Errors) != 0 || !strings.Contains(string(resp.Data["Torrents"]), "debian:13.iso") {
+		t.Errorf("Torrents after login: got %+v", resp)
+	}
+
+	rec, _ = post(t, e, `mutation { logout }`, cookies, "wrong")
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("wrong CSRF token: status %d, want 403", rec.Code)
+	}
+
+	_, resp = post(t, e, `mutation { logout }`, cookies, login.CsrfToken)
+	if string(resp.Data["logout"]) != "true" {
+		t.Errorf("logout: got %+v", resp)
+	}
+
+	_, resp = post(t, e, `{ Torrents { Name } }`, cookies, login.CsrfToken)
+	if resp.code() != "UNAUTHENTICATED" {
+		t.Errorf("Torrents after logout: got %+v, want UNAUTHENTICATED", resp)
+	}
+}
+
+func TestLoginFailures(t *testing.T) {
+	e := routers.NewEchoHandler(routers.NewGraphqlHandler())
+
+	// The default auth.loginAttempts is 5.
+	for range 5 {
+		_, resp := post(t, e, `mutation { login(args: {Username: "admin", Password: "wrong"}) { User } }`, nil, "")
+		if resp.code() != "UNAUTHENTICATED" {
+			t.Fatalf("wrong password: got %+v, want UNAUTHENTICATED", resp)
+		}
+	}
+
+	_, resp := post(t, e, `mutation { login(args: {Username: "admin", Password: "hunter2"}) { User } }`, nil, "")
+	if resp.code() != "TOO_MANY_LOGINS" {
+		t.Errorf("right password after too many failures: got %+v, want TOO_MANY_LOGINS", resp)
+	}
+}
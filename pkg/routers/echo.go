@@ -8,6 +8,7 @@ import (
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/auth"
 	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
 	"github.com/kingsukhoi/qbitorrent-panel/pkg/httpHandlers"
 	"github.com/labstack/echo/v5"
@@ -22,19 +23,31 @@ func NewEchoHandler(gqlHandler *handler.Server) *echo.Echo {
 	e.Use(middleware.RequestLogger())
 	e.Use(middleware.Recover())
 
+	// Sessions are checked by every route but the health checks and the frontend's files, which are needed to
+	// show the login form. The GraphQL endpoint serves the login mutation, so it checks the login per operation.
+	sessions, failures := auth.NewSessions(), auth.NewLoginFailures()
+	requireLogin := httpHandlers.Authenticate(sessions, failures, true)
+
 	// GraphQL endpoint
-	e.POST("/query", echo.WrapHandler(gqlHandler))
+	e.POST("/query", echo.WrapHandler(gqlHandler), httpHandlers.Authenticate(sessions, failures, false))
+
+	config := configuration.MustGetConfig()
+	// The playground needs introspection, which is off in production.
+	playgroundEnabled := config.GetEnv() != configuration.EnvProd
 
 	// GraphQL playground
-	e.GET("/playground", echo.WrapHandler(playground.Handler("GraphQL Playground", "/query")))
+	if playgroundEnabled {
+		e.GET("/playground", echo.WrapHandler(playground.Handler("GraphQL Playground", "/query")), requireLogin)
+	}
 
-	// Health check endpoint
+	// Health check endpoints, served from the state kept by the background probes
 	e.GET("/health", httpHandlers.HealthCheck)
 	e.GET("/healthz", httpHandlers.HealthCheck)
+	e.GET("/ready", httpHandlers.ReadyCheck)
+	e.GET("/health/servers", httpHandlers.HealthDetails, requireLogin)
 
-	e.POST("/uploadTorrent", httpHandlers.TorrentUpload)
-
-	config := configuration.MustGetConfig()
+	e.POST("/uploadTorrent", httpHandlers.TorrentUpload, requireLogin)
+	e.GET("/exportTorrent", httpHandlers.TorrentExport, requireLogin)
 
 	fePathExists, err := pathExist(config.FrontEndPath)
 	if err != nil {
@@ -56,8 +69,8 @@ func NewEchoHandler(gqlHandler *handler.Server) *echo.Echo {
 			Index: "index.html",
 			HTML5: true,
 		}))
-	} else {
-		e.GET("/", echo.WrapHandler(playground.Handler("GraphQL Playground", "/query")))
+	} else if playgroundEnabled {
+		e.GET("/", echo.WrapHandler(playground.Handler("GraphQL Playground", "/query")), requireLogin)
 	}
 
 	return e
@@ -3,25 +3,57 @@ package routers
 import (
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/kingsukhoi/qbitorrent-panel/pkg/configuration"
 	"github.com/kingsukhoi/qbitorrent-panel/pkg/gqlGenerated"
 	"github.com/kingsukhoi/qbitorrent-panel/pkg/gqlResolvers"
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
+// queryCacheSize is how many parsed queries are kept, so a query sent again isn't parsed and validated again.
+const queryCacheSize = 1000
+
 func NewGraphqlHandler() *handler.Server {
+	config := configuration.MustGetConfig()
+
 	// Create resolver
 	resolver := &gqlResolvers.Resolver{}
 
 	// Create GraphQL server
 	h := handler.New(gqlGenerated.NewExecutableSchema(gqlGenerated.Config{
-		Resolvers: resolver,
+		Resolvers:  resolver,
+		Complexity: gqlResolvers.Complexity(),
 	}))
 
 	h.AddTransport(transport.Options{})
 	h.AddTransport(transport.GET{})
 	h.AddTransport(transport.POST{})
 
-	h.Use(extension.Introspection{})
+	h.SetQueryCache(lru.New[*ast.QueryDocument](queryCacheSize))
+
+	h.Use(gqlResolvers.RequireLogin{})
+
+	// Introspection lets anyone map the whole API, it's only for development.
+	if config.GetEnv() != configuration.EnvProd {
+		h.Use(extension.Introspection{})
+	}
+	if config.GraphQL.PersistedQueries > 0 {
+		h.Use(extension.AutomaticPersistedQuery{Cache: lru.New[string](config.GraphQL.PersistedQueries)})
+	}
+	if config.GraphQL.ComplexityLimit > 0 {
+		h.Use(extension.FixedComplexityLimit(config.GraphQL.ComplexityLimit))
+	}
+	if config.GraphQL.DepthLimit > 0 {
+		h.Use(gqlResolvers.DepthLimit{Limit: config.GraphQL.DepthLimit})
+	}
+	h.Use(gqlResolvers.Loaders{})
+
+	if config.GetEnv() == configuration.EnvProd {
+		h.SetErrorPresenter(gqlResolvers.ProductionErrorPresenter)
+	} else {
+		h.SetErrorPresenter(gqlResolvers.ErrorPresenter)
+	}
 
 	return h
 }